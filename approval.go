@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/function-sdk-go/resource"
+
+	"github.com/gympass/function-aws-importer/input/v1beta1"
+)
+
+// annotationKeyApproveImport approves full management of resources imported in "ObserveOnly" mode when set on the XR.
+// Its value is either "true", approving all resources, or a comma-separated list of composition names.
+const annotationKeyApproveImport = "function-aws-importer.gympass.com/approve-import"
+
+// importApproval tells which resources imported in "ObserveOnly" mode are approved for full management
+type importApproval struct {
+	all   bool
+	names []string
+}
+
+func (a importApproval) approves(compositionName string) bool {
+	return a.all || slices.Contains(a.names, compositionName)
+}
+
+// resolveImportApproval reads approvals from the XR's annotation and from the input's approval path, if any
+func resolveImportApproval(in *v1beta1.Input, xr *resource.Composite) (importApproval, error) {
+	approval := parseApproval(xr.Resource.GetAnnotations()[annotationKeyApproveImport])
+
+	if len(in.ApprovalPath) == 0 {
+		return approval, nil
+	}
+
+	v, err := xr.Resource.GetValue(in.ApprovalPath)
+	if fieldpath.IsNotFound(err) {
+		return approval, nil
+	}
+	if err != nil {
+		return importApproval{}, fmt.Errorf("getting approvalPath (%q) from XR: %v", in.ApprovalPath, err)
+	}
+
+	switch t := v.(type) {
+	case bool:
+		approval.all = approval.all || t
+	case string:
+		fromPath := parseApproval(t)
+		approval.all = approval.all || fromPath.all
+		approval.names = append(approval.names, fromPath.names...)
+	case []any:
+		for i, e := range t {
+			name, ok := e.(string)
+			if !ok {
+				return importApproval{}, fmt.Errorf("approvalPath (%q) element %d is not a string: %T", in.ApprovalPath, i, e)
+			}
+			approval.names = append(approval.names, name)
+		}
+	default:
+		return importApproval{}, fmt.Errorf("approvalPath (%q) must be a boolean, a string or a list of strings, got %T", in.ApprovalPath, v)
+	}
+
+	return approval, nil
+}
+
+func parseApproval(v string) importApproval {
+	v = strings.TrimSpace(v)
+	if len(v) == 0 {
+		return importApproval{}
+	}
+	if v == "true" || v == "*" {
+		return importApproval{all: true}
+	}
+
+	var names []string
+	for _, n := range strings.Split(v, ",") {
+		if n = strings.TrimSpace(n); len(n) > 0 {
+			names = append(names, n)
+		}
+	}
+	return importApproval{names: names}
+}
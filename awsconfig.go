@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	runtimeresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// awsConfigFinder looks external resources up by their Crossplane tags with an advanced query on an AWS Config
+// aggregator, which searches resources of all the accounts and regions it aggregates at once
+type awsConfigFinder struct {
+	client     configservice.SelectAggregateResourceConfigAPIClient
+	aggregator string
+	// accountID is the account resources must belong to, any if empty
+	accountID string
+}
+
+// awsConfigResult is a row of the advanced query
+type awsConfigResult struct {
+	AccountID    string `json:"accountId"`
+	AWSRegion    string `json:"awsRegion"`
+	ARN          string `json:"arn"`
+	ResourceID   string `json:"resourceId"`
+	ResourceType string `json:"resourceType"`
+	Tags         []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"tags"`
+}
+
+func (a awsConfigFinder) UsesTags() bool { return true }
+
+func (a awsConfigFinder) Find(ctx context.Context, req FindRequest) ([]Candidate, error) {
+	region, _, err := req.Resource.DesiredString("spec.forProvider.region")
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := awsConfigQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := configservice.NewSelectAggregateResourceConfigPaginator(a.client, &configservice.SelectAggregateResourceConfigInput{
+		ConfigurationAggregatorName: aws.String(a.aggregator),
+		Expression:                  aws.String(query),
+	})
+
+	// the query matches tag keys and values independently, so tags are matched in pairs after querying, as are the
+	// input's tag filters
+	tagFilters := tagFiltersFor(req.Filters, req.Resource, req.Kind)
+
+	var candidates []Candidate
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("querying aggregator %q: %v", a.aggregator, err)
+		}
+
+		for _, row := range page.Results {
+			var result awsConfigResult
+			if err := json.Unmarshal([]byte(row), &result); err != nil {
+				return nil, fmt.Errorf("parsing query result: %v", err)
+			}
+
+			tags := make([]types.Tag, 0, len(result.Tags))
+			for _, t := range result.Tags {
+				tags = append(tags, types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+			}
+			if !matchesAllTagFilters(tags, tagFilters) {
+				continue
+			}
+			// regional resources sharing tags in other regions, like in multi-region deployments, aren't the same one
+			if len(region) > 0 && !req.Resource.IsGlobal() && result.AWSRegion != region {
+				continue
+			}
+			// likewise, resources of other accounts the aggregator spans aren't the ones the provider manages
+			if len(a.accountID) > 0 && result.AccountID != a.accountID {
+				continue
+			}
+
+			candidates = append(candidates, Candidate{
+				ARN:          result.ARN,
+				AccountID:    result.AccountID,
+				Region:       result.AWSRegion,
+				ResourceID:   result.ResourceID,
+				Tags:         tags,
+				ExternalName: awsConfigExternalName(req, result.ARN, tags),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// awsConfigQuery selects resources with the "crossplane-name" and "crossplane-kind" tags of req, of the kind's AWS Config
+// resource type if it's known. Values are inlined in the query, so ones with quotes are refused: Kubernetes names can't
+// have them, but kind aliases come from the input as is.
+func awsConfigQuery(req FindRequest) (string, error) {
+	for _, v := range []string{req.Resource.K8sName(), req.Kind} {
+		if strings.ContainsAny(v, `'"`) {
+			return "", fmt.Errorf("cannot query AWS Config for %q, it contains quotes", v)
+		}
+	}
+
+	conditions := []string{
+		fmt.Sprintf("tags.key = '%s'", runtimeresource.ExternalResourceTagKeyName),
+		fmt.Sprintf("tags.value = '%s'", req.Resource.K8sName()),
+		fmt.Sprintf("tags.key = '%s'", runtimeresource.ExternalResourceTagKeyKind),
+		fmt.Sprintf("tags.value = '%s'", req.Kind),
+	}
+	if typeName := req.Resource.AWSConfigType(); len(typeName) > 0 {
+		conditions = append(conditions, fmt.Sprintf("resourceType = '%s'", typeName))
+	}
+	return "SELECT accountId, awsRegion, arn, resourceId, resourceType, tags WHERE " + strings.Join(conditions, " AND "), nil
+}
+
+// awsConfigExternalName returns the external name from the external-name tag or, if it's missing, from the ARN when the
+// kind's external name can be derived from it. Resource IDs in AWS Config aren't always external names, like IAM
+// roles' unique IDs, so they're not used.
+func awsConfigExternalName(req FindRequest, arn string, tags []types.Tag) string {
+	if externalName := tagValue(tags, externalNameTag); len(externalName) > 0 {
+		return externalName
+	}
+	externalName, _ := req.Resource.ExternalNameFromARN(arn)
+	return externalName
+}
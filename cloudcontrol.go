@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// cloudControlFinder looks external resources up by listing all resources of their CloudFormation type with the Cloud
+// Control API, then matching the tags in their properties. It's slower than the Tagging API, as it lists every resource
+// of the type, but covers resources whose tags the Tagging API doesn't know about. Types whose list handler doesn't
+// return tags never match.
+type cloudControlFinder struct {
+	client cloudcontrol.ListResourcesAPIClient
+}
+
+func (c cloudControlFinder) UsesTags() bool { return true }
+
+// Find returns no candidates if the CloudFormation type of the resource's kind is unknown
+func (c cloudControlFinder) Find(ctx context.Context, req FindRequest) ([]Candidate, error) {
+	typeName := req.Resource.CloudFormationType()
+	if len(typeName) == 0 {
+		return nil, nil
+	}
+
+	region, _, err := req.Resource.DesiredString("spec.forProvider.region")
+	if err != nil {
+		return nil, err
+	}
+
+	tagFilters := tagFiltersFor(req.Filters, req.Resource, req.Kind)
+	paginator := cloudcontrol.NewListResourcesPaginator(c.client, &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	})
+
+	var candidates []Candidate
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, func(o *cloudcontrol.Options) {
+			// global resources, like IAM's, are listed from the default region
+			if len(region) > 0 && !req.Resource.IsGlobal() {
+				o.Region = region
+			}
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing %s resources: %v", typeName, err)
+		}
+
+		for _, r := range page.ResourceDescriptions {
+			tags, err := cloudControlTags(aws.ToString(r.Properties))
+			if err != nil {
+				return nil, fmt.Errorf("reading tags of %s %q: %v", typeName, aws.ToString(r.Identifier), err)
+			}
+			if !matchesAllTagFilters(tags, tagFilters) {
+				continue
+			}
+			candidates = append(candidates, Candidate{
+				Tags:         tags,
+				ExternalName: aws.ToString(r.Identifier),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// cloudControlTags reads the "Tags" property of a resource, which is either a list of {Key, Value} objects or a map,
+// depending on its type
+func cloudControlTags(properties string) ([]types.Tag, error) {
+	if len(properties) == 0 {
+		return nil, nil
+	}
+
+	var model struct {
+		Tags json.RawMessage `json:"Tags"`
+	}
+	if err := json.Unmarshal([]byte(properties), &model); err != nil {
+		return nil, fmt.Errorf("parsing properties: %v", err)
+	}
+	if len(model.Tags) == 0 {
+		return nil, nil
+	}
+
+	var list []struct{ Key, Value string }
+	if err := json.Unmarshal(model.Tags, &list); err == nil {
+		tags := make([]types.Tag, 0, len(list))
+		for _, t := range list {
+			tags = append(tags, types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+		return tags, nil
+	}
+
+	var m map[string]string
+	if err := json.Unmarshal(model.Tags, &m); err != nil {
+		return nil, fmt.Errorf("tags are neither a list of key-value objects nor a map: %v", err)
+	}
+	tags := make([]types.Tag, 0, len(m))
+	for k, v := range m {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	slices.SortFunc(tags, func(a, b types.Tag) int { return strings.Compare(aws.ToString(a.Key), aws.ToString(b.Key)) })
+	return tags, nil
+}
+
+// matchesAllTagFilters follows the semantics of Resource Groups Tagging API filters: tags match if they match each of
+// the filters, see matchesAnyTagFilter
+func matchesAllTagFilters(tags []types.Tag, tagFilters []types.TagFilter) bool {
+	for _, filter := range tagFilters {
+		if !matchesAnyTagFilter(tags, []types.TagFilter{filter}) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	fnv1 "github.com/crossplane/function-sdk-go/proto/v1"
+	"github.com/crossplane/function-sdk-go/request"
+	"github.com/crossplane/function-sdk-go/resource"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gympass/function-aws-importer/internal"
+)
+
+// extraResourceKeyPrefix prefixes the keys of CRDs required by the function, followed by the CRD name
+const extraResourceKeyPrefix = "function-aws-importer/crd/"
+
+// kindDiscovery discovers whether and how kinds support tags from their CRDs, which are required from Crossplane,
+// caching the answers
+type kindDiscovery struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu    sync.Mutex
+	cache map[schema.GroupVersionKind]discoveredKind
+}
+
+type discoveredKind struct {
+	// found is false if the kind's CRD or its version don't exist, in which case the kinds registry is used as is
+	found    bool
+	override internal.TagsOverride
+	expires  time.Time
+}
+
+// newKindDiscovery returns a kindDiscovery caching answers for ttl. A zero ttl disables caching.
+func newKindDiscovery(ttl time.Duration) *kindDiscovery {
+	return &kindDiscovery{ttl: ttl, now: time.Now, cache: map[schema.GroupVersionKind]discoveredKind{}}
+}
+
+// discoveryResult holds what's known about the kinds of desired AWS managed resources
+type discoveryResult struct {
+	overrides internal.TagsOverrides
+	// requirements for CRDs, nil if all answers are cached
+	requirements *fnv1.Requirements
+	// pending is true if Crossplane is yet to deliver some of the required CRDs
+	pending bool
+}
+
+// discover returns tag overrides for the kinds of desired AWS managed resources whose CRDs are known, along with the
+// requirements for the CRDs of the remaining ones. Requirements are stable across calls of the same request, so
+// Crossplane stops calling the function once it has delivered the CRDs.
+func (d *kindDiscovery) discover(req *fnv1.RunFunctionRequest) (discoveryResult, error) {
+	gvks, err := desiredAWSGroupVersionKinds(req)
+	if err != nil {
+		return discoveryResult{}, err
+	}
+	extra, err := request.GetExtraResources(req)
+	if err != nil {
+		return discoveryResult{}, fmt.Errorf("getting extra resources: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := discoveryResult{overrides: internal.TagsOverrides{}}
+	requirements := &fnv1.Requirements{ExtraResources: map[string]*fnv1.ResourceSelector{}}
+	for _, gvk := range gvks {
+		crdName := crdNameFor(gvk)
+		key := extraResourceKeyPrefix + crdName
+
+		kind, cached := d.cached(gvk)
+		if crds, delivered := extra[key]; delivered {
+			kind, err = discoverKind(gvk, crds)
+			if err != nil {
+				return discoveryResult{}, fmt.Errorf("inspecting CRD %s: %v", crdName, err)
+			}
+			d.store(gvk, kind)
+			cached = true
+			// keep requiring the CRD, otherwise Crossplane would call the function again
+			requirements.ExtraResources[key] = crdSelector(crdName)
+		}
+
+		if !cached {
+			requirements.ExtraResources[key] = crdSelector(crdName)
+			result.pending = true
+			continue
+		}
+		if kind.found {
+			result.overrides[strings.ToLower(gvk.GroupKind().String())] = kind.override
+		}
+	}
+
+	if len(requirements.ExtraResources) > 0 {
+		result.requirements = requirements
+	}
+	return result, nil
+}
+
+func (d *kindDiscovery) cached(gvk schema.GroupVersionKind) (discoveredKind, bool) {
+	kind, ok := d.cache[gvk]
+	if !ok || d.now().After(kind.expires) {
+		return discoveredKind{}, false
+	}
+	return kind, true
+}
+
+func (d *kindDiscovery) store(gvk schema.GroupVersionKind, kind discoveredKind) {
+	if d.ttl <= 0 {
+		return
+	}
+	kind.expires = d.now().Add(d.ttl)
+	d.cache[gvk] = kind
+}
+
+// discoverKind inspects the served version of the kind's CRD, if delivered, looking for tags in .spec.forProvider
+func discoverKind(gvk schema.GroupVersionKind, crds []resource.Extra) (discoveredKind, error) {
+	if len(crds) == 0 {
+		return discoveredKind{}, nil
+	}
+
+	crd := extv1.CustomResourceDefinition{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(crds[0].Resource.Object, &crd); err != nil {
+		return discoveredKind{}, err
+	}
+
+	i := slices.IndexFunc(crd.Spec.Versions, func(v extv1.CustomResourceDefinitionVersion) bool {
+		return v.Name == gvk.Version && v.Served
+	})
+	if i < 0 {
+		return discoveredKind{}, nil
+	}
+
+	kind := discoveredKind{found: true}
+	v := crd.Spec.Versions[i]
+	if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+		return kind, nil
+	}
+
+	forProvider := v.Schema.OpenAPIV3Schema.Properties["spec"].Properties["forProvider"].Properties
+	switch {
+	case forProvider["tags"].Type == "object":
+		kind.override = internal.TagsOverride{Field: "tags"}
+	case forProvider["tags"].Type == "array":
+		// classic provider-aws kinds
+		kind.override = listTagsOverride("tags", forProvider["tags"])
+	case forProvider["tag"].Type == "array":
+		kind.override = listTagsOverride("tag", forProvider["tag"])
+	}
+	return kind, nil
+}
+
+// listTagsOverride returns the override of list-shaped tags, whose entries have either "key" and "value" fields or,
+// in ACK-generated kinds, "tagKey" and "tagValue" fields
+func listTagsOverride(field string, schema extv1.JSONSchemaProps) internal.TagsOverride {
+	override := internal.TagsOverride{Field: field, List: true}
+	if schema.Items == nil || schema.Items.Schema == nil {
+		return override
+	}
+
+	entry := schema.Items.Schema.Properties
+	_, hasTagKey := entry["tagKey"]
+	_, hasTagValue := entry["tagValue"]
+	if hasTagKey && hasTagValue {
+		override.EntryKey, override.EntryValue = "tagKey", "tagValue"
+	}
+	return override
+}
+
+// desiredAWSGroupVersionKinds returns the sorted, unique group-version-kinds of desired AWS managed resources
+func desiredAWSGroupVersionKinds(req *fnv1.RunFunctionRequest) ([]schema.GroupVersionKind, error) {
+	desired, err := request.GetDesiredComposedResources(req)
+	if err != nil {
+		return nil, fmt.Errorf("getting desired composed resources: %v", err)
+	}
+
+	var gvks []schema.GroupVersionKind
+	for _, dc := range desired {
+		if !internal.IsAWSManagedResource(dc.Resource.GetAPIVersion()) {
+			continue
+		}
+		if gvk := dc.Resource.GroupVersionKind(); !slices.Contains(gvks, gvk) {
+			gvks = append(gvks, gvk)
+		}
+	}
+	slices.SortFunc(gvks, func(a, b schema.GroupVersionKind) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return gvks, nil
+}
+
+// crdNameFor guesses the CRD name of a kind, "<plural>.<group>". Provider CRDs follow the conventional pluralization.
+func crdNameFor(gvk schema.GroupVersionKind) string {
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	return plural.Resource + "." + gvk.Group
+}
+
+func crdSelector(name string) *fnv1.ResourceSelector {
+	return &fnv1.ResourceSelector{
+		ApiVersion: "apiextensions.k8s.io/v1",
+		Kind:       "CustomResourceDefinition",
+		Match:      &fnv1.ResourceSelector_MatchName{MatchName: name},
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/aws/smithy-go"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	runtimeresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/gympass/function-aws-importer/input/v1beta1"
+	"github.com/gympass/function-aws-importer/internal"
+)
+
+// Finder looks up the external resources of desired composed resources. Deciding which of the candidates, if any, is
+// imported is up to the function, so finders only need to list them.
+type Finder interface {
+	// Find returns the external resources matching req, or none if the finder doesn't support the resource's kind
+	Find(ctx context.Context, req FindRequest) ([]Candidate, error)
+	// UsesTags returns true if the finder looks external resources up by their Crossplane tags, and so must be asked
+	// again with each kind alias. Finders that don't are only asked once, with the resource's own group-kind.
+	UsesTags() bool
+}
+
+// FindRequest describes the external resource to look up
+type FindRequest struct {
+	// Resource is the desired composed resource whose external resource is looked up
+	Resource internal.Resource
+	// Filters are the input's tag filters, resolved against the observed XR
+	Filters InputTagFilters
+	// Kind is the "crossplane-kind" tag value to look the external resource up with, the resource's own group-kind or
+	// one of its aliases
+	Kind string
+	// KindAliases are the legacy "crossplane-kind" tag values the external resource may have been tagged with instead
+	// of the resource's own group-kind
+	KindAliases []string
+}
+
+// Candidate is an external resource found by a Finder
+type Candidate struct {
+	// ARN of the external resource, if known
+	ARN string
+	// AccountID, Region and ResourceID of the external resource, if known
+	AccountID  string
+	Region     string
+	ResourceID string
+	// Tags of the external resource, used to apply exclude tag filters
+	Tags []types.Tag
+	// ExternalName of the external resource, empty if it's unknown
+	ExternalName string
+}
+
+// String identifies the candidate in logs and messages
+func (c Candidate) String() string {
+	if len(c.ARN) > 0 {
+		return c.ARN
+	}
+	return c.ExternalName
+}
+
+// finderFor returns the finder with the given name, and false if it's not enabled in the function. IAM finders share
+// the entities they list through iamEntities.
+func (f *Function) finderFor(name v1beta1.Finder, iamEntities *iamEntityCache) (Finder, bool) {
+	switch name {
+	case v1beta1.FinderTaggingAPI:
+		return taggingAPIFinder{client: f.client}, f.client != nil
+	case v1beta1.FinderSecurityGroupNaturalKey:
+		return securityGroupFinder{log: f.log, client: f.securityGroups}, f.securityGroups != nil
+	case v1beta1.FinderHostedZoneNaturalKey:
+		return hostedZoneFinder{log: f.log, client: f.hostedZones}, f.hostedZones != nil
+	case v1beta1.FinderCloudControl:
+		return cloudControlFinder{client: f.cloudControl}, f.cloudControl != nil
+	case v1beta1.FinderAWSConfig:
+		return awsConfigFinder{client: f.awsConfig, aggregator: f.awsConfigAggregator, accountID: f.awsConfigAccountID}, f.awsConfig != nil && len(f.awsConfigAggregator) > 0
+	case v1beta1.FinderIAM:
+		return newIAMFinder(f.iam, iamEntities), f.iam != nil
+	}
+	return nil, false
+}
+
+// taggingAPIFinder looks external resources up by their Crossplane tags with the Resource Groups Tagging API
+type taggingAPIFinder struct {
+	client resourcegroupstaggingapi.GetResourcesAPIClient
+}
+
+func (t taggingAPIFinder) UsesTags() bool { return true }
+
+func (t taggingAPIFinder) Find(ctx context.Context, req FindRequest) ([]Candidate, error) {
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(t.client, &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters:          tagFiltersFor(req.Filters, req.Resource, req.Kind),
+		ResourceTypeFilters: resourceTypeFilters(req.Resource),
+	})
+
+	var candidates []Candidate
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting resources tag mappings: %v", err)
+		}
+
+		for _, t := range page.ResourceTagMappingList {
+			candidates = append(candidates, Candidate{
+				ARN:          aws.ToString(t.ResourceARN),
+				Tags:         t.Tags,
+				ExternalName: tagValue(t.Tags, externalNameTag),
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// authorizationErrorCodes are the error codes of AWS APIs denying a call to the function's identity
+var authorizationErrorCodes = []string{"AccessDenied", "AccessDeniedException", "UnauthorizedOperation"}
+
+// isAuthorizationError returns true if err means the function's identity isn't allowed to make a call
+func isAuthorizationError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && slices.Contains(authorizationErrorCodes, apiErr.ErrorCode())
+}
+
+// namesOtherResource returns true if tags have Crossplane name or kind tags, and they don't name the resource req looks
+// up. Resources found by natural key with such tags belong to other resources that happen to share their natural key,
+// like the leftovers of a renamed resource, which must not be imported twice.
+func namesOtherResource(tags []types.Tag, req FindRequest) bool {
+	if name := tagValue(tags, runtimeresource.ExternalResourceTagKeyName); len(name) > 0 && name != req.Resource.K8sName() {
+		return true
+	}
+	kind := tagValue(tags, runtimeresource.ExternalResourceTagKeyKind)
+	return len(kind) > 0 && kind != req.Resource.GroupKind() && !slices.Contains(req.KindAliases, kind)
+}
+
+func tagValue(tags []types.Tag, key string) string {
+	for _, t := range tags {
+		// TODO(lcaparelli): make this a parameter for the function, allow users to fetch external-name value from any tag
+		if aws.ToString(t.Key) == key {
+			return aws.ToString(t.Value)
+		}
+	}
+	return ""
+}
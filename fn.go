@@ -3,16 +3,21 @@ package main
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	runtimeresource "github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/function-sdk-go/logging"
 	fnv1 "github.com/crossplane/function-sdk-go/proto/v1"
 	"github.com/crossplane/function-sdk-go/request"
-	"github.com/crossplane/function-sdk-go/resource"
 	"github.com/crossplane/function-sdk-go/response"
 
 	"github.com/gympass/function-aws-importer/input/v1beta1"
@@ -29,6 +34,31 @@ type Function struct {
 
 	log    logging.Logger
 	client resourcegroupstaggingapi.GetResourcesAPIClient
+	// taggableKinds extend or override the built-in kinds registry, but not the input's additionalTaggableKinds
+	taggableKinds []v1beta1.TaggableKind
+	// discovery caches taggable kinds discovered from CRDs, see v1beta1.Input.DiscoverTaggableKinds
+	discovery *kindDiscovery
+	// securityGroups looks security groups up by their natural key when tags find nothing, disabled if nil
+	securityGroups ec2.DescribeSecurityGroupsAPIClient
+	// hostedZones looks Route53 hosted zones up by their natural key when tags find nothing, disabled if nil
+	hostedZones hostedZonesAPIClient
+	// cloudControl lists resources for the "CloudControl" finder, disabled if nil
+	cloudControl cloudcontrol.ListResourcesAPIClient
+	// awsConfig queries awsConfigAggregator for the "AWSConfig" finder, disabled if either is unset
+	awsConfig           configservice.SelectAggregateResourceConfigAPIClient
+	awsConfigAggregator string
+	// awsConfigAccountID is the account resources found by the "AWSConfig" finder must belong to, any if empty
+	awsConfigAccountID string
+	// iam lists IAM entities for the "IAM" finder, disabled if nil
+	iam iamAPIClient
+}
+
+func (f *Function) kindDiscovery() *kindDiscovery {
+	if f.discovery == nil {
+		// no caching
+		return newKindDiscovery(0)
+	}
+	return f.discovery
 }
 
 // RunFunction runs the Function.
@@ -54,7 +84,28 @@ func (f *Function) RunFunction(ctx context.Context, req *fnv1.RunFunctionRequest
 		return rsp, nil
 	}
 
-	resources, err := internal.NewResources(req)
+	var discovered internal.TagsOverrides
+	if in.DiscoverTaggableKinds {
+		result, err := f.kindDiscovery().discover(req)
+		if err != nil {
+			f.log.Info("Failed to discover taggable kinds.",
+				"error", err,
+			)
+			response.Fatal(rsp, fmt.Errorf("cannot discover taggable kinds from CRDs: %v", err))
+			return rsp, nil
+		}
+
+		rsp.Requirements = result.requirements
+		if result.pending {
+			f.log.Debug("Requiring CRDs of desired composed resources", "requirements", result.requirements)
+			return rsp, nil
+		}
+		discovered = result.overrides
+	}
+
+	resources, err := internal.NewResources(req, func(desiredComposed internal.Resource) bool {
+		return in.Manages(desiredComposed)
+	}, internal.WithTagsOverrides(tagsOverrides(discovered, f.taggableKinds, in.AdditionalTaggableKinds)))
 	if err != nil {
 		f.log.Info("Failed to extract observed and desired composed resources.",
 			"error", err,
@@ -63,13 +114,58 @@ func (f *Function) RunFunction(ctx context.Context, req *fnv1.RunFunctionRequest
 		return rsp, nil
 	}
 
+	skipped := resources.SkippedCompositionNames()
+	if len(skipped) > 0 {
+		f.log.Debug("Skipped composed resources not managed by the function", "resources", skipped)
+		response.Normalf(rsp, "skipped composed resources excluded from import: %v", skipped)
+	}
+
+	if resources.LenDesired() == 0 && len(skipped) > 0 {
+		return rsp, nil
+	}
+
 	if resources.LenDesired() == 0 {
 		f.log.Info("Empty desired composed resources")
 		response.Warning(rsp, errors.New("found no desired composed resources. Are you running the function before other steps that define the resources? It should always run after them."))
 		return rsp, nil
 	}
 
-	err = resources.EnsureExternalNameTags(externalNameTag)
+	approval, err := f.holdPendingImports(req, in, resources)
+	if err != nil {
+		f.log.Info("Failed to keep resources pending approval in observe-only mode.",
+			"error", err,
+		)
+		response.Fatal(rsp, fmt.Errorf("cannot keep resources pending approval in observe-only mode: %v", err))
+		return rsp, nil
+	}
+	if len(approval.pending) > 0 {
+		response.Normalf(rsp, "imported resources pending approval for full management: %v", approval.pending)
+	}
+	if len(approval.approved) > 0 {
+		response.Normalf(rsp, "imported resources approved for full management: %v", approval.approved)
+	}
+
+	kept, err := f.keepForcedOrphans(in, resources)
+	if err != nil {
+		f.log.Info("Failed to keep forced deletion policies.",
+			"error", err,
+		)
+		response.Fatal(rsp, fmt.Errorf("cannot keep forced %q deletion policies: %v", xpv1.DeletionOrphan, err))
+		return rsp, nil
+	}
+	if len(kept) > 0 {
+		response.Normalf(rsp, "kept forced %q deletion policy on imported resources: %v", xpv1.DeletionOrphan, kept)
+	}
+
+	if err := resources.EnsureExternalNameAnnotations(); err != nil {
+		f.log.Info("Failed to ensure external name annotations.",
+			"error", err,
+		)
+		response.Fatal(rsp, fmt.Errorf("cannot ensure external name annotations: %v", err))
+		return rsp, nil
+	}
+
+	unguaranteed, err := resources.EnsureExternalNameTags(externalNameTag)
 	if err != nil {
 		f.log.Info("Failed to ensure external name tags.",
 			"error", err,
@@ -77,6 +173,10 @@ func (f *Function) RunFunction(ctx context.Context, req *fnv1.RunFunctionRequest
 		response.Fatal(rsp, fmt.Errorf("cannot ensure external name tags: %v", err))
 		return rsp, nil
 	}
+	if len(unguaranteed) > 0 {
+		f.log.Info("Cannot guarantee external name tags reach AWS.", "resources", unguaranteed)
+		response.Warning(rsp, fmt.Errorf("cannot guarantee the %q tag reaches AWS, as management policies don't allow updates or tags are only defined in .spec.initProvider: %v", externalNameTag, unguaranteed))
+	}
 
 	if resources.LenObserved() > 0 && resources.AllHaveExternalNamesSet() {
 		err := response.SetDesiredComposedResources(rsp, resources.DesiredComposedResources())
@@ -97,13 +197,57 @@ func (f *Function) RunFunction(ctx context.Context, req *fnv1.RunFunctionRequest
 		return rsp, nil
 	}
 
+	filters, err := f.resolveInputTagFilters(req, in)
+	if err != nil {
+		response.Fatal(rsp, fmt.Errorf("cannot resolve tag filters: %v", err))
+		return rsp, nil
+	}
+
+	if len(filters.skipped) > 0 {
+		skippedKeys := extractKeys(filters.skipped)
+		f.log.Debug("Skipped optional tag filters", "keys", skippedKeys)
+		response.Normalf(rsp, "skipped optional tag filters whose path is not set on the XR: %v", skippedKeys)
+	}
+
+	safety := &safetyReport{}
+	var observeOnly, aliased, byOtherFinders []string
+	iamEntities := newIAMEntityCache()
 	err = resources.ForEachDesiredComposed(func(desiredComposed internal.Resource) error {
-		externalName, err := f.fetchExternalNameFromAWS(ctx, req, in, desiredComposed)
+		gk := desiredComposed.GroupKind()
+		lookup, err := f.fetchExternalNameFromAWS(ctx, filters, iamEntities, in.FindersFor(gk), in.KindAliasesFor(gk), desiredComposed)
 		if err != nil {
 			return fmt.Errorf("fetching external name from AWS: %v", err)
 		}
+		externalName := lookup.externalName
+
+		if len(lookup.matchedAlias) > 0 {
+			aliased = append(aliased, fmt.Sprintf("%s (%s)", desiredComposed.CompositionName(), lookup.matchedAlias))
+		}
+		if len(lookup.finder) > 0 && lookup.finder != v1beta1.FinderTaggingAPI {
+			byOtherFinders = append(byOtherFinders, fmt.Sprintf("%s (%s)", desiredComposed.CompositionName(), lookup.foundWith()))
+		}
 
-		return resources.SetDesiredExternalName(desiredComposed.CompositionName(), externalName)
+		if len(externalName) > 0 {
+			// enforced whatever the import mode: resources imported in observe-only mode can't delete anything yet, but
+			// get full management once approved, when they're not imported, nor checked, again
+			refused, err := f.enforceSafetyPolicy(in.SafetyPolicy, resources, desiredComposed, safety)
+			if err != nil {
+				return fmt.Errorf("enforcing safety policy: %v", err)
+			}
+			if refused {
+				return nil
+			}
+
+			compName := desiredComposed.CompositionName()
+			if in.ImportMode == v1beta1.ImportModeObserveOnly && !resources.IsObserved(compName) && !approval.approves(compName) {
+				if err := resources.SetDesiredObserveOnly(compName); err != nil {
+					return fmt.Errorf("importing in observe-only mode: %v", err)
+				}
+				observeOnly = append(observeOnly, compName)
+			}
+		}
+
+		return resources.SetDesiredExternalName(desiredComposed.CompositionName(), externalName, externalNameTag)
 	})
 	if err != nil {
 		f.log.Info("Failed to reconcile desired managed resource.",
@@ -113,6 +257,32 @@ func (f *Function) RunFunction(ctx context.Context, req *fnv1.RunFunctionRequest
 		return rsp, nil
 	}
 
+	if len(safety.refused) > 0 {
+		slices.Sort(safety.refused)
+		response.Fatal(rsp, fmt.Errorf("refusing to import resources whose deletion would also delete the external resource. Set the %q deletion policy or stop the %q management policy: %v", xpv1.DeletionOrphan, xpv1.ManagementActionDelete, safety.refused))
+		return rsp, nil
+	}
+	if len(safety.warned) > 0 {
+		slices.Sort(safety.warned)
+		response.Warning(rsp, fmt.Errorf("imported resources whose deletion would also delete the external resource, consider using the %q deletion policy: %v", xpv1.DeletionOrphan, safety.warned))
+	}
+	if len(safety.orphaned) > 0 {
+		slices.Sort(safety.orphaned)
+		response.Normalf(rsp, "forced %q deletion policy on imported resources: %v", xpv1.DeletionOrphan, safety.orphaned)
+	}
+	if len(aliased) > 0 {
+		slices.Sort(aliased)
+		response.Normalf(rsp, "found resources by legacy %q tag values, which should be re-tagged: %v", runtimeresource.ExternalResourceTagKeyKind, aliased)
+	}
+	if len(byOtherFinders) > 0 {
+		slices.Sort(byOtherFinders)
+		response.Normalf(rsp, "found resources with finders other than %q, the %q tag will be added to them: %v", v1beta1.FinderTaggingAPI, externalNameTag, byOtherFinders)
+	}
+	if len(observeOnly) > 0 {
+		slices.Sort(observeOnly)
+		response.Normalf(rsp, "imported resources in observe-only mode, pending approval for full management: %v", observeOnly)
+	}
+
 	if !resources.FoundExistingResources() {
 		desiredResourcesCompName := resources.DesiredResourcesCompositionNames()
 		f.log.Info("External resources not found", "resources", desiredResourcesCompName)
@@ -138,111 +308,354 @@ func (f *Function) RunFunction(ctx context.Context, req *fnv1.RunFunctionRequest
 	return rsp, nil
 }
 
-func (f *Function) fetchExternalNameFromAWS(ctx context.Context, req *fnv1.RunFunctionRequest, in *v1beta1.Input, desiredComposed internal.Resource) (string, error) {
-	tagFilters, err := f.extractTagFilters(desiredComposed, req, in)
-	if err != nil {
-		return "", fmt.Errorf("extracting tag filters: %v", err)
+// approvalReport holds the composition names of resources imported in observe-only mode on previous reconciles
+type approvalReport struct {
+	// approved can now be fully managed
+	approved []string
+	// pending still need approval for full management
+	pending  []string
+	approval importApproval
+}
+
+func (a approvalReport) approves(compositionName string) bool {
+	return a.approval.approves(compositionName)
+}
+
+// holdPendingImports keeps the "Observe" management policy on desired composed resources imported in observe-only mode
+// until they're approved for full management on the XR
+func (f *Function) holdPendingImports(req *fnv1.RunFunctionRequest, in *v1beta1.Input, resources internal.Resources) (approvalReport, error) {
+	if in.ImportMode != v1beta1.ImportModeObserveOnly {
+		return approvalReport{}, nil
 	}
 
-	tagMappings, err := f.getResourceTagMappings(ctx, tagFilters)
+	xr, err := request.GetObservedCompositeResource(req)
 	if err != nil {
-		return "", fmt.Errorf("getting resource tag mappings: %v", err)
+		return approvalReport{}, fmt.Errorf("extracting observed XR from req: %v", err)
 	}
 
-	if len(tagMappings) > 1 {
-		f.log.Info("Cannot decide which resource to import.",
-			"error", errors.New("found more than one resource matching tag filters"),
-			"tagFilters", tagFilters,
-			"matchingResources", extractARNs(tagMappings),
-		)
-		return "", fmt.Errorf("found more than one resource matching tag filters: %v", extractARNs(tagMappings))
+	approval, err := resolveImportApproval(in, xr)
+	if err != nil {
+		return approvalReport{}, fmt.Errorf("resolving import approval: %v", err)
 	}
 
-	if len(tagMappings) == 0 {
-		f.log.Debug("External resource not found",
-			"tagFilters", tagFilters,
-		)
-		return "", nil
+	report := approvalReport{approval: approval}
+	for _, name := range resources.PendingApprovalCompositionNames() {
+		if approval.approves(name) {
+			report.approved = append(report.approved, name)
+			continue
+		}
+
+		if err := resources.SetDesiredObserveOnly(name); err != nil {
+			return approvalReport{}, err
+		}
+		report.pending = append(report.pending, name)
 	}
 
-	tags := tagMappings[0].Tags
-	f.log.Debug("Found resource with matching tags",
-		"tags", tags,
-		"tagFilters", tagFilters,
+	f.log.Debug("Checked approval of resources imported in observe-only mode",
+		"approved", report.approved,
+		"pending", report.pending,
 	)
+	return report, nil
+}
+
+// keepForcedOrphans keeps the "Orphan" deletion policy forced on desired composed resources when importing them on
+// previous reconciles, as long as the "ForceOrphan" safety policy is used. It returns their composition names.
+func (f *Function) keepForcedOrphans(in *v1beta1.Input, resources internal.Resources) ([]string, error) {
+	if in.SafetyPolicy != v1beta1.SafetyPolicyForceOrphan {
+		return nil, nil
+	}
 
-	return f.extractExternalName(tags)
+	names := resources.ForcedOrphanCompositionNames()
+	for _, name := range names {
+		if err := resources.ForceDesiredOrphan(name); err != nil {
+			return nil, err
+		}
+	}
+
+	f.log.Debug("Kept forced deletion policies", "resources", names)
+	return names, nil
+}
+
+// safetyReport holds the composition names of resources affected by the safety policy
+type safetyReport struct {
+	warned   []string
+	refused  []string
+	orphaned []string
 }
 
-func (f *Function) extractExternalName(tags []types.Tag) (string, error) {
-	var externalName string
-	for _, t := range tags {
-		// TODO(lcaparelli): make this a parameter for the function, allow users to fetch external-name value from any tag
-		if aws.ToString(t.Key) == externalNameTag {
-			externalName = aws.ToString(t.Value)
-			break
+// enforceSafetyPolicy applies policy to a desired composed resource that's about to be imported, returning true if the
+// policy refuses to import it
+func (f *Function) enforceSafetyPolicy(policy v1beta1.SafetyPolicy, resources internal.Resources, desiredComposed internal.Resource, report *safetyReport) (bool, error) {
+	if len(policy) == 0 || policy == v1beta1.SafetyPolicyIgnore {
+		return false, nil
+	}
+
+	deletes, err := desiredComposed.DeletesExternalResource()
+	if err != nil {
+		return false, fmt.Errorf("checking deletion and management policies: %v", err)
+	}
+	if !deletes {
+		return false, nil
+	}
+
+	compName := desiredComposed.CompositionName()
+	f.log.Info("Importing resource whose deletion would also delete the external resource.",
+		"resource", compName,
+		"safetyPolicy", policy,
+	)
+
+	switch policy {
+	case v1beta1.SafetyPolicyWarn:
+		report.warned = append(report.warned, compName)
+	case v1beta1.SafetyPolicyRefuse:
+		report.refused = append(report.refused, compName)
+		return true, nil
+	case v1beta1.SafetyPolicyForceOrphan:
+		if err := resources.ForceDesiredOrphan(compName); err != nil {
+			return false, err
 		}
+		report.orphaned = append(report.orphaned, compName)
 	}
+	return false, nil
+}
 
-	if len(externalName) == 0 {
-		f.log.Info("Cannot fetch external name from tags.",
-			"error", errors.New("tag does not exist or is empty"),
-			"existingTags", tags,
-			"externalNameTagKey", externalNameTag,
-		)
-		return "", fmt.Errorf("found resource matching tag filters, but %q tag is not present or is empty", externalNameTag)
+// lookupResult is the outcome of looking up the external resource of a desired composed resource on AWS
+type lookupResult struct {
+	externalName string
+	// matchedAlias is the legacy "crossplane-kind" value the external resource was found with, empty if it was found
+	// with the actual group-kind or not found at all
+	matchedAlias string
+	// finder is the finder that found the external resource, empty if it was not found
+	finder v1beta1.Finder
+	// accountID and region are where the external resource was found, empty if the finder doesn't know
+	accountID, region string
+}
+
+// foundWith describes how the external resource was found, for results listing resources found by finders other than
+// the Tagging API. Finders searching several accounts and regions report where the resource was, so it can be checked.
+func (l lookupResult) foundWith() string {
+	parts := []string{string(l.finder)}
+	if len(l.accountID) > 0 {
+		parts = append(parts, "account "+l.accountID)
+	}
+	if len(l.region) > 0 {
+		parts = append(parts, "region "+l.region)
 	}
-	return externalName, nil
+	return strings.Join(parts, ", ")
 }
 
-func (f *Function) getResourceTagMappings(ctx context.Context, tagFilters []types.TagFilter) ([]types.ResourceTagMapping, error) {
-	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(f.client, &resourcegroupstaggingapi.GetResourcesInput{
-		TagFilters: tagFilters,
-	})
+// fetchExternalNameFromAWS looks up the external resource with each of the given finders in order, until one of them
+// finds it. Each finder looks it up with the desired composed resource's group-kind, then with each of the kind aliases
+// in order, unless it doesn't rely on tags. When no finders are given, the default ones are used, and those other than
+// the Tagging API are skipped if the function's identity isn't allowed to use them, so they don't need permissions.
+func (f *Function) fetchExternalNameFromAWS(ctx context.Context, filters InputTagFilters, iamEntities *iamEntityCache, finders []v1beta1.Finder, aliases []string, desiredComposed internal.Resource) (lookupResult, error) {
+	explicit := len(finders) > 0
+	if !explicit {
+		finders = v1beta1.DefaultFinders(desiredComposed.GroupKind())
+	}
 
-	var tagMappings []types.ResourceTagMapping
-	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("getting resources tag mappings: %v", err)
+finders:
+	for _, name := range finders {
+		finder, enabled := f.finderFor(name, iamEntities)
+		if !enabled && explicit {
+			return lookupResult{}, fmt.Errorf("finder %q is not enabled in the function", name)
+		}
+		if !enabled {
+			continue
 		}
 
-		for _, t := range page.ResourceTagMappingList {
-			tagMappings = append(tagMappings, t)
+		for _, kind := range lookupKinds(finder, desiredComposed, aliases) {
+			candidate, found, err := f.fetchExternalNameWithFinder(ctx, finder, FindRequest{
+				Resource:    desiredComposed,
+				Filters:     filters,
+				Kind:        kind,
+				KindAliases: aliases,
+			})
+			if err != nil && !explicit && name != v1beta1.FinderTaggingAPI && isAuthorizationError(err) {
+				f.log.Info("Skipping default finder the function is not allowed to use.",
+					"finder", name,
+					"resource", desiredComposed.CompositionName(),
+					"error", err,
+				)
+				continue finders
+			}
+			if err != nil {
+				return lookupResult{}, fmt.Errorf("looking up with %q finder: %v", name, err)
+			}
+			if !found {
+				continue
+			}
+
+			result := lookupResult{
+				externalName: candidate.ExternalName,
+				finder:       name,
+				accountID:    candidate.AccountID,
+				region:       candidate.Region,
+			}
+			if kind != desiredComposed.GroupKind() {
+				f.log.Debug("Found resource with legacy kind alias",
+					"resource", desiredComposed.CompositionName(),
+					"alias", kind,
+				)
+				result.matchedAlias = kind
+			}
+			return result, nil
 		}
 	}
-	return tagMappings, nil
+	return lookupResult{}, nil
+}
+
+// lookupKinds returns the "crossplane-kind" tag values finder looks desiredComposed up with: its own group-kind, then
+// its aliases. Finders that don't use tags only look it up once, with its own group-kind.
+func lookupKinds(finder Finder, desiredComposed internal.Resource, aliases []string) []string {
+	if !finder.UsesTags() {
+		return []string{desiredComposed.GroupKind()}
+	}
+	return append([]string{desiredComposed.GroupKind()}, aliases...)
+}
+
+// fetchExternalNameWithFinder decides which of the candidates found by finder is the external resource of req.Resource.
+// It fails if more than one candidate remains once exclude tag filters are applied, or if the remaining one's external
+// name is unknown.
+func (f *Function) fetchExternalNameWithFinder(ctx context.Context, finder Finder, req FindRequest) (Candidate, bool, error) {
+	candidates, err := finder.Find(ctx, req)
+	if err != nil {
+		return Candidate{}, false, err
+	}
+
+	excludeTagFilters := applicableTagFilters(req.Filters.exclude, req.Resource)
+	candidates, excluded := excludeCandidates(candidates, excludeTagFilters)
+	if len(excluded) > 0 {
+		f.log.Debug("Excluded resources matching exclude tag filters",
+			"excludeTagFilters", excludeTagFilters,
+			"excludedResources", excluded,
+		)
+	}
+
+	if len(candidates) > 1 {
+		f.log.Info("Cannot decide which resource to import.",
+			"error", errors.New("found more than one matching resource"),
+			"resource", req.Resource.CompositionName(),
+			"kind", req.Kind,
+			"matchingResources", candidates,
+		)
+		return Candidate{}, false, fmt.Errorf("found more than one matching resource: %v", candidates)
+	}
+
+	if len(candidates) == 0 {
+		f.log.Debug("External resource not found",
+			"resource", req.Resource.CompositionName(),
+			"kind", req.Kind,
+		)
+		return Candidate{}, false, nil
+	}
+
+	found := candidates[0]
+	f.log.Debug("Found matching resource",
+		"resource", req.Resource.CompositionName(),
+		"kind", req.Kind,
+		"found", found,
+		"tags", found.Tags,
+	)
+
+	if len(found.ExternalName) == 0 {
+		f.log.Info("Cannot fetch external name from tags.",
+			"error", errors.New("tag does not exist or is empty"),
+			"existingTags", found.Tags,
+			"externalNameTagKey", externalNameTag,
+		)
+		return Candidate{}, false, fmt.Errorf("found resource %s, but %q tag is not present or is empty", found, externalNameTag)
+	}
+	return found, true, nil
 }
 
-func (f *Function) extractTagFilters(desiredComposed internal.Resource, req *fnv1.RunFunctionRequest, in *v1beta1.Input) ([]types.TagFilter, error) {
+// InputTagFilters are the tag filters from the Function input, resolved against the observed XR
+type InputTagFilters struct {
+	include []v1beta1.ResolvedTagFilter
+	exclude []v1beta1.ResolvedTagFilter
+	// skipped are optional filters whose path is not set on the XR
+	skipped []v1beta1.TagFilter
+}
+
+func (f *Function) resolveInputTagFilters(req *fnv1.RunFunctionRequest, in *v1beta1.Input) (InputTagFilters, error) {
 	xr, err := request.GetObservedCompositeResource(req)
 	if err != nil {
-		return nil, fmt.Errorf("extracting observed XR from req: %v", err)
+		return InputTagFilters{}, fmt.Errorf("extracting observed XR from req: %v", err)
 	}
 
-	tagFilters, err := resolveTagFilters(in, xr, desiredComposed)
+	include, skippedInclude, err := in.ResolveTagFilters(xr)
 	if err != nil {
 		f.log.Info("Failed to resolve tag filters.",
 			"error", err,
 			"tagFilters", in.TagFilters,
 			"xr", xr,
-			"managedResource", desiredComposed,
 		)
-		return nil, err
+		return InputTagFilters{}, fmt.Errorf("resolving input tag filters: %v", err)
 	}
-	return tagFilters, nil
-}
 
-func resolveTagFilters(in *v1beta1.Input, xr *resource.Composite, res internal.Resource) ([]types.TagFilter, error) {
-	additionalFilters, err := in.ResolveTagFilters(xr)
+	exclude, skippedExclude, err := in.ResolveExcludeTagFilters(xr)
 	if err != nil {
-		return nil, fmt.Errorf("resolving input tag filters: %v", err)
+		f.log.Info("Failed to resolve exclude tag filters.",
+			"error", err,
+			"excludeTagFilters", in.ExcludeTagFilters,
+			"xr", xr,
+		)
+		return InputTagFilters{}, fmt.Errorf("resolving input exclude tag filters: %v", err)
+	}
+
+	return InputTagFilters{
+		include: include,
+		exclude: exclude,
+		skipped: append(skippedInclude, skippedExclude...),
+	}, nil
+}
+
+// excludeCandidates splits candidates between the ones that match none of the given filters and the ones matching
+// at least one of them
+func excludeCandidates(candidates []Candidate, excludeTagFilters []types.TagFilter) (kept, excluded []Candidate) {
+	for _, c := range candidates {
+		if matchesAnyTagFilter(c.Tags, excludeTagFilters) {
+			excluded = append(excluded, c)
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept, excluded
+}
+
+// matchesAnyTagFilter follows the semantics of a single Resource Groups Tagging API filter: tags match a filter if the
+// key is present and, when the filter has values, the tag's value is one of them
+func matchesAnyTagFilter(tags []types.Tag, tagFilters []types.TagFilter) bool {
+	for _, filter := range tagFilters {
+		for _, tag := range tags {
+			if aws.ToString(tag.Key) != aws.ToString(filter.Key) {
+				continue
+			}
+			if len(filter.Values) == 0 || slices.Contains(filter.Values, aws.ToString(tag.Value)) {
+				return true
+			}
+		}
 	}
+	return false
+}
 
-	return append(additionalFilters, nameAndKindFilters(res)...), nil
+// tagFiltersFor returns the tag filters to look res up with, using kind as "crossplane-kind" tag value
+func tagFiltersFor(filters InputTagFilters, res internal.Resource, kind string) []types.TagFilter {
+	return append(applicableTagFilters(filters.include, res), nameAndKindFilters(res, kind)...)
 }
 
-func nameAndKindFilters(res internal.Resource) []types.TagFilter {
+// applicableTagFilters returns the filters whose resource selector matches res
+func applicableTagFilters(filters []v1beta1.ResolvedTagFilter, res internal.Resource) []types.TagFilter {
+	var result []types.TagFilter
+	for _, tf := range filters {
+		if tf.AppliesTo(res) {
+			result = append(result, tf.TagFilter)
+		}
+	}
+	return result
+}
+
+func nameAndKindFilters(res internal.Resource, kind string) []types.TagFilter {
 	return []types.TagFilter{
 		{
 			Key:    aws.String(runtimeresource.ExternalResourceTagKeyName),
@@ -250,15 +663,24 @@ func nameAndKindFilters(res internal.Resource) []types.TagFilter {
 		},
 		{
 			Key:    aws.String(runtimeresource.ExternalResourceTagKeyKind),
-			Values: []string{res.GroupKind()},
+			Values: []string{kind},
 		},
 	}
 }
 
-func extractARNs(tagMappings []types.ResourceTagMapping) []string {
-	var arns []string
-	for _, t := range tagMappings {
-		arns = append(arns, aws.ToString(t.ResourceARN))
+// resourceTypeFilters narrows lookups down to the resource type of res, if known, so AWS doesn't scan every resource
+// type in the region, and resources of other types sharing tags aren't matched
+func resourceTypeFilters(res internal.Resource) []string {
+	if resourceType := res.AWSResourceType(); len(resourceType) > 0 {
+		return []string{resourceType}
+	}
+	return nil
+}
+
+func extractKeys(tagFilters []v1beta1.TagFilter) []string {
+	var keys []string
+	for _, tf := range tagFilters {
+		keys = append(keys, tf.Key)
 	}
-	return arns
+	return keys
 }
@@ -2,7 +2,11 @@ package main
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	fnv1 "github.com/crossplane/function-sdk-go/proto/v1"
 	"github.com/google/go-cmp/cmp"
@@ -10,15 +14,22 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudcontroltypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/smithy-go"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	runtimeresource "github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/function-sdk-go/resource"
 	"github.com/crossplane/function-sdk-go/response"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/gympass/function-aws-importer/input/v1beta1"
+	"github.com/gympass/function-aws-importer/internal/inventory"
 	"github.com/gympass/function-aws-importer/internal/test"
 )
 
@@ -512,6 +523,12 @@ func (s *functionSuite) TestRunFunction_InvalidInput_ShouldFail() {
 				}},
 			},
 		},
+		{
+			name: "Input has an additional taggable kind with no group-kind",
+			in: &v1beta1.Input{
+				AdditionalTaggableKinds: []v1beta1.TaggableKind{{TagsField: "tags"}},
+			},
+		},
 		{
 			name: "Input has a tag filter with no strategy",
 			in: &v1beta1.Input{
@@ -522,6 +539,55 @@ func (s *functionSuite) TestRunFunction_InvalidInput_ShouldFail() {
 				}},
 			},
 		},
+		{
+			name: "Input has a tag filter using 'values' strategy, but didn't inform the values",
+			in: &v1beta1.Input{
+				TagFilters: []v1beta1.TagFilter{{
+					Key:      "foo",
+					Strategy: "values",
+				}},
+			},
+		},
+		{
+			name: "Input has a tag filter using 'values' strategy, but one of the values is empty",
+			in: &v1beta1.Input{
+				TagFilters: []v1beta1.TagFilter{{
+					Key:      "foo",
+					Strategy: "values",
+					Values:   []string{"bar", ""},
+				}},
+			},
+		},
+		{
+			name: "Input has a tag filter using 'valuesPath' strategy, but didn't inform the values path",
+			in: &v1beta1.Input{
+				TagFilters: []v1beta1.TagFilter{{
+					Key:        "foo",
+					Strategy:   "valuesPath",
+					ValuesPath: "",
+				}},
+			},
+		},
+		{
+			name: "Input has a tag filter using 'exists' strategy, but informed a value",
+			in: &v1beta1.Input{
+				TagFilters: []v1beta1.TagFilter{{
+					Key:      "foo",
+					Strategy: "exists",
+					Value:    "bar",
+				}},
+			},
+		},
+		{
+			name: "Input has an exclude tag filter with no key",
+			in: &v1beta1.Input{
+				ExcludeTagFilters: []v1beta1.TagFilter{{
+					Key:      "",
+					Strategy: "value",
+					Value:    "bar",
+				}},
+			},
+		},
 		{
 			name: "Input uses an invalid strategy in a tag filter",
 			in: &v1beta1.Input{
@@ -976,3 +1042,1933 @@ func (s *functionSuite) TestRunFunction_FilterMatchesButResourceHasNoExternalNam
 
 	s.Equal(s.req().Desired, rsp.Desired)
 }
+
+func (s *functionSuite) TestRunFunction_MultipleTagFilterMatchesButOthersAreExcluded_ShouldSetExternalNameFromRemainingMatch() {
+	s.in.ExcludeTagFilters = []v1beta1.TagFilter{
+		{
+			Key:      "lifecycle",
+			Strategy: "value",
+			Value:    "decommissioned",
+		},
+		{
+			Key:      "managed-by",
+			Strategy: "exists",
+		},
+	}
+
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{
+			{
+				ResourceARN: aws.String("arn:aws:ec2:us-east-1:123456789012:security-group/sg-1"),
+				Tags: []types.Tag{
+					{
+						Key:   aws.String(externalNameTag),
+						Value: aws.String("some-external-name"),
+					},
+					{
+						Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+						Value: aws.String("test"),
+					},
+				},
+			},
+			{
+				ResourceARN: aws.String("arn:aws:ec2:us-east-1:123456789012:security-group/sg-2"),
+				Tags: []types.Tag{
+					{
+						Key:   aws.String(externalNameTag),
+						Value: aws.String("decommissioned-external-name"),
+					},
+					{
+						Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+						Value: aws.String("test"),
+					},
+					{
+						Key:   aws.String("lifecycle"),
+						Value: aws.String("decommissioned"),
+					},
+				},
+			},
+			{
+				ResourceARN: aws.String("arn:aws:ec2:us-east-1:123456789012:security-group/sg-3"),
+				Tags: []types.Tag{
+					{
+						Key:   aws.String(externalNameTag),
+						Value: aws.String("terraform-external-name"),
+					},
+					{
+						Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+						Value: aws.String("test"),
+					},
+					{
+						Key:   aws.String("managed-by"),
+						Value: aws.String("terraform"),
+					},
+				},
+			},
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+
+	got := rsp.GetDesired().GetResources()["securityGroup"].GetResource().
+		GetFields()["metadata"].GetStructValue().
+		GetFields()["annotations"].GetStructValue().
+		GetFields()["crossplane.io/external-name"].GetStringValue()
+	s.Equal("some-external-name", got)
+}
+
+func (s *functionSuite) TestRunFunction_OptionalTagFilterPathIsUnset_ShouldSkipFilter() {
+	s.in.TagFilters = []v1beta1.TagFilter{{
+		Key:       "key",
+		Strategy:  "valuePath",
+		ValuePath: "some.field.that.doesnt.exist",
+		Optional:  true,
+	}}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+
+	s.Len(rsp.Results, 3)
+	for _, r := range rsp.Results {
+		s.Equalf(fnv1.Severity_SEVERITY_NORMAL, r.Severity, "msg: %s", r.GetMessage())
+	}
+	s.Contains(rsp.Results[0].GetMessage(), "skipped optional tag filters")
+	s.Contains(rsp.Results[0].GetMessage(), "key")
+
+	s.Truef(proto.Equal(s.req().Desired, rsp.Desired), "diff: %s", cmp.Diff(s.req().Desired, rsp.Desired, protocmp.Transform()))
+}
+
+func (s *functionSuite) TestRunFunction_TagFilterWithResourceSelector_ShouldOnlyApplyToSelectedResources() {
+	s.in.TagFilters = []v1beta1.TagFilter{{
+		Key:      "Name",
+		Strategy: "value",
+		Value:    "test",
+		ResourceSelector: &v1beta1.ResourceSelector{
+			CompositionNames: []string{"securityGroup"},
+		},
+	}}
+
+	client := &test.FakeGetResourcesAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Len(rsp.Results, 2)
+
+	s.Len(client.Inputs, 3)
+	for _, in := range client.Inputs {
+		var crossplaneName string
+		var hasNameFilter bool
+		for _, tf := range in.TagFilters {
+			switch aws.ToString(tf.Key) {
+			case runtimeresource.ExternalResourceTagKeyName:
+				crossplaneName = tf.Values[0]
+			case "Name":
+				hasNameFilter = true
+			}
+		}
+
+		s.Equalf(crossplaneName == "test", hasNameFilter, "crossplane-name: %s", crossplaneName)
+	}
+}
+
+func (s *functionSuite) TestRunFunction_ExcludedResources_ShouldNotBeImported() {
+	s.in.Exclude = []v1beta1.ResourceSelector{{
+		CompositionNames: []string{"test-1-*"},
+	}}
+
+	req := s.req()
+	req.Desired.Resources["test-0-ipv4"].Resource.GetFields()["metadata"].GetStructValue().Fields["annotations"] = structpb.NewStructValue(&structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"function-aws-importer.gympass.com/skip-import": structpb.NewStringValue("true"),
+		},
+	})
+
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{
+			{
+				Tags: []types.Tag{
+					{
+						Key:   aws.String(externalNameTag),
+						Value: aws.String("some-external-name"),
+					},
+					{
+						Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+						Value: aws.String("test"),
+					},
+				},
+			},
+			{
+				Tags: []types.Tag{
+					{
+						Key:   aws.String(externalNameTag),
+						Value: aws.String("some-external-name"),
+					},
+					{
+						Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+						Value: aws.String("test-0-ipv4"),
+					},
+				},
+			},
+			{
+				Tags: []types.Tag{
+					{
+						Key:   aws.String(externalNameTag),
+						Value: aws.String("some-external-name"),
+					},
+					{
+						Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+						Value: aws.String("test-1-ipv4"),
+					},
+				},
+			},
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), req)
+
+	s.NoError(err)
+
+	s.Len(rsp.Results, 2)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[test-0-ipv4 test-1-ipv4]")
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[1].Severity, "msg: %s", rsp.Results[1].GetMessage())
+
+	s.Len(client.Inputs, 1)
+
+	got := rsp.GetDesired().GetResources()["securityGroup"].GetResource().
+		GetFields()["metadata"].GetStructValue().
+		GetFields()["annotations"].GetStructValue().
+		GetFields()["crossplane.io/external-name"].GetStringValue()
+	s.Equal("some-external-name", got)
+
+	s.True(proto.Equal(req.Desired.Resources["test-0-ipv4"], rsp.Desired.Resources["test-0-ipv4"]))
+	s.True(proto.Equal(req.Desired.Resources["test-1-ipv4"], rsp.Desired.Resources["test-1-ipv4"]))
+}
+
+func (s *functionSuite) TestRunFunction_AllResourcesExcluded_ShouldDoNothing() {
+	s.in.Include = []v1beta1.ResourceSelector{{
+		GroupKinds: []string{"bucket.s3.aws.upbound.io"},
+	}}
+
+	client := &test.FakeGetResourcesAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Empty(client.Inputs)
+	s.Truef(proto.Equal(s.req().Desired, rsp.Desired), "diff: %s", cmp.Diff(s.req().Desired, rsp.Desired, protocmp.Transform()))
+}
+
+func (s *functionSuite) TestRunFunction_ImportingResourceThatWouldBeDeleted_ShouldEnforceSafetyPolicy() {
+	testCases := []struct {
+		name             string
+		policy           v1beta1.SafetyPolicy
+		wantSeverities   []fnv1.Severity
+		wantImported     bool
+		wantDeletePolicy string
+	}{
+		{
+			name:             "Ignore",
+			policy:           v1beta1.SafetyPolicyIgnore,
+			wantSeverities:   []fnv1.Severity{fnv1.Severity_SEVERITY_NORMAL},
+			wantImported:     true,
+			wantDeletePolicy: "Delete",
+		},
+		{
+			name:             "Warn",
+			policy:           v1beta1.SafetyPolicyWarn,
+			wantSeverities:   []fnv1.Severity{fnv1.Severity_SEVERITY_WARNING, fnv1.Severity_SEVERITY_NORMAL},
+			wantImported:     true,
+			wantDeletePolicy: "Delete",
+		},
+		{
+			name:             "Refuse",
+			policy:           v1beta1.SafetyPolicyRefuse,
+			wantSeverities:   []fnv1.Severity{fnv1.Severity_SEVERITY_FATAL},
+			wantImported:     false,
+			wantDeletePolicy: "Delete",
+		},
+		{
+			name:             "ForceOrphan",
+			policy:           v1beta1.SafetyPolicyForceOrphan,
+			wantSeverities:   []fnv1.Severity{fnv1.Severity_SEVERITY_NORMAL, fnv1.Severity_SEVERITY_NORMAL},
+			wantImported:     true,
+			wantDeletePolicy: "Orphan",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.in.SafetyPolicy = tc.policy
+
+			req := s.req()
+			// deleting the SG would delete the external resource
+			sgSpec := req.Desired.Resources["securityGroup"].Resource.GetFields()["spec"].GetStructValue()
+			sgSpec.Fields["deletionPolicy"] = structpb.NewStringValue("Delete")
+			// deleting the rule wouldn't, because its management policies don't allow it
+			ruleSpec := req.Desired.Resources["test-0-ipv4"].Resource.GetFields()["spec"].GetStructValue()
+			ruleSpec.Fields["deletionPolicy"] = structpb.NewStringValue("Delete")
+			ruleSpec.Fields["managementPolicies"] = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewStringValue("Observe"),
+				structpb.NewStringValue("Create"),
+				structpb.NewStringValue("Update"),
+			}})
+
+			client := &test.FakeGetResourcesAPIClient{
+				Resources: []types.ResourceTagMapping{
+					{
+						Tags: []types.Tag{
+							{
+								Key:   aws.String(externalNameTag),
+								Value: aws.String("some-external-name"),
+							},
+							{
+								Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+								Value: aws.String("test"),
+							},
+						},
+					},
+					{
+						Tags: []types.Tag{
+							{
+								Key:   aws.String(externalNameTag),
+								Value: aws.String("some-external-name"),
+							},
+							{
+								Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+								Value: aws.String("test-0-ipv4"),
+							},
+						},
+					},
+				},
+			}
+
+			fn := &Function{log: logging.NewNopLogger(), client: client}
+			rsp, err := fn.RunFunction(context.Background(), req)
+
+			s.NoError(err)
+
+			var gotSeverities []fnv1.Severity
+			for _, r := range rsp.Results {
+				gotSeverities = append(gotSeverities, r.Severity)
+			}
+			s.Equal(tc.wantSeverities, gotSeverities)
+
+			sg := rsp.GetDesired().GetResources()["securityGroup"].GetResource()
+			gotExternalName := sg.GetFields()["metadata"].GetStructValue().
+				GetFields()["annotations"].GetStructValue().
+				GetFields()["crossplane.io/external-name"].GetStringValue()
+			s.Equal(tc.wantImported, gotExternalName == "some-external-name")
+
+			gotDeletionPolicy := sg.GetFields()["spec"].GetStructValue().GetFields()["deletionPolicy"].GetStringValue()
+			s.Equal(tc.wantDeletePolicy, gotDeletionPolicy)
+
+			if tc.wantImported {
+				rule := rsp.GetDesired().GetResources()["test-0-ipv4"].GetResource()
+				s.Equal("Delete", rule.GetFields()["spec"].GetStructValue().GetFields()["deletionPolicy"].GetStringValue())
+			}
+		})
+	}
+}
+
+func (s *functionSuite) TestRunFunction_RefuseSafetyPolicy_ShouldReportAllRefusedResources() {
+	s.in.SafetyPolicy = v1beta1.SafetyPolicyRefuse
+
+	client := &test.FakeGetResourcesAPIClient{}
+	for _, name := range []string{"test", "test-0-ipv4", "test-1-ipv4"} {
+		client.Resources = append(client.Resources, types.ResourceTagMapping{
+			Tags: []types.Tag{
+				{Key: aws.String(externalNameTag), Value: aws.String("some-external-name")},
+				{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String(name)},
+			},
+		})
+	}
+
+	req := s.req()
+	for _, name := range []string{"securityGroup", "test-1-ipv4"} {
+		spec := req.Desired.Resources[name].Resource.GetFields()["spec"].GetStructValue()
+		spec.Fields["deletionPolicy"] = structpb.NewStringValue("Delete")
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), req)
+
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup test-1-ipv4]")
+	s.Equal(req.Desired, rsp.Desired)
+
+	// resources imported in observe-only mode could delete their external resources once approved
+	s.in.ImportMode = v1beta1.ImportModeObserveOnly
+	req.Input = resource.MustStructObject(s.in)
+
+	rsp, err = fn.RunFunction(context.Background(), req)
+
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup test-1-ipv4]")
+	s.Equal(req.Desired, rsp.Desired)
+}
+
+func (s *functionSuite) TestRunFunction_ForceOrphanSafetyPolicy_ShouldKeepOrphanOnFollowingReconciles() {
+	s.in.SafetyPolicy = v1beta1.SafetyPolicyForceOrphan
+
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{Key: aws.String(externalNameTag), Value: aws.String("some-external-name")},
+				{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String("test")},
+			},
+		}},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+
+	// earlier steps always render the resource with the "Delete" deletion policy
+	withDeletePolicy := func(req *fnv1.RunFunctionRequest) *fnv1.RunFunctionRequest {
+		spec := req.Desired.Resources["securityGroup"].Resource.GetFields()["spec"].GetStructValue()
+		spec.Fields["deletionPolicy"] = structpb.NewStringValue("Delete")
+		return req
+	}
+	deletionPolicy := func(rsp *fnv1.RunFunctionResponse) any {
+		return rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["spec"].(map[string]any)["deletionPolicy"]
+	}
+	annotations := func(rsp *fnv1.RunFunctionResponse) map[string]any {
+		return rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"].(map[string]any)
+	}
+
+	// first reconcile, the resource is imported
+	rsp, err := fn.RunFunction(context.Background(), withDeletePolicy(s.req()))
+	s.NoError(err)
+	s.Equal("Orphan", deletionPolicy(rsp))
+	s.Equal("true", annotations(rsp)["function-aws-importer.gympass.com/forced-orphan"])
+
+	// second reconcile, all resources are observed with their external names and the annotation the function set
+	req := withDeletePolicy(s.reqWithObservedExternalName("some-external-name"))
+	req.Observed.Resources["securityGroup"].Resource.GetFields()["metadata"].GetStructValue().
+		GetFields()["annotations"].GetStructValue().
+		Fields["function-aws-importer.gympass.com/forced-orphan"] = structpb.NewStringValue("true")
+
+	rsp, err = fn.RunFunction(context.Background(), req)
+	s.NoError(err)
+	s.Contains(rsp.Results[0].GetMessage(), "kept forced")
+	s.Contains(rsp.Results[len(rsp.Results)-1].GetMessage(), "already set for all resources")
+	s.Equal("Orphan", deletionPolicy(rsp))
+	s.Equal("true", annotations(rsp)["function-aws-importer.gympass.com/forced-orphan"])
+}
+
+func (s *functionSuite) TestRunFunction_ForceOrphanSafetyPolicyInObserveOnlyImportMode_ShouldKeepOrphanOnceApproved() {
+	s.in.SafetyPolicy = v1beta1.SafetyPolicyForceOrphan
+	s.in.ImportMode = v1beta1.ImportModeObserveOnly
+
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{Key: aws.String(externalNameTag), Value: aws.String("some-external-name")},
+				{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String("test")},
+			},
+		}},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+
+	// earlier steps always render the resource with the "Delete" deletion policy
+	withDeletePolicy := func(req *fnv1.RunFunctionRequest) *fnv1.RunFunctionRequest {
+		spec := req.Desired.Resources["securityGroup"].Resource.GetFields()["spec"].GetStructValue()
+		spec.Fields["deletionPolicy"] = structpb.NewStringValue("Delete")
+		return req
+	}
+	spec := func(rsp *fnv1.RunFunctionResponse) map[string]any {
+		return rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["spec"].(map[string]any)
+	}
+
+	// first reconcile, the resource is imported in observe-only mode, and orphaned already
+	rsp, err := fn.RunFunction(context.Background(), withDeletePolicy(s.req()))
+	s.NoError(err)
+	s.Equal([]any{"Observe"}, spec(rsp)["managementPolicies"])
+	s.Equal("Orphan", spec(rsp)["deletionPolicy"])
+
+	// second reconcile, the resource is approved on the XR
+	req := withDeletePolicy(s.reqWithObservedExternalName("some-external-name"))
+	observedAnnotations := req.Observed.Resources["securityGroup"].Resource.GetFields()["metadata"].GetStructValue().
+		GetFields()["annotations"].GetStructValue()
+	observedAnnotations.Fields["function-aws-importer.gympass.com/pending-approval"] = structpb.NewStringValue("true")
+	observedAnnotations.Fields["function-aws-importer.gympass.com/forced-orphan"] = structpb.NewStringValue("true")
+	req.Observed.Composite = &fnv1.Resource{Resource: resource.MustStructJSON(`
+		{
+			"apiVersion": "acme.io/v1beta1",
+			"kind": "XSomeResource",
+			"metadata": {
+				"name": "test",
+				"annotations": {"function-aws-importer.gympass.com/approve-import": "true"}
+			}
+		}`)}
+
+	rsp, err = fn.RunFunction(context.Background(), req)
+	s.NoError(err)
+	s.Contains(rsp.Results[0].GetMessage(), "approved for full management")
+	s.NotContains(spec(rsp), "managementPolicies")
+	s.Equal("Orphan", spec(rsp)["deletionPolicy"])
+}
+
+func (s *functionSuite) TestRunFunction_ObserveOnlyImportMode_ShouldKeepObservePolicyUntilApproved() {
+	s.in.ImportMode = v1beta1.ImportModeObserveOnly
+
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String("some-external-name"),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+					Value: aws.String("test"),
+				},
+			},
+		}},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+
+	managementPolicies := func(rsp *fnv1.RunFunctionResponse, name string) []any {
+		return rsp.GetDesired().GetResources()[name].GetResource().AsMap()["spec"].(map[string]any)["managementPolicies"].([]any)
+	}
+	annotations := func(rsp *fnv1.RunFunctionResponse, name string) map[string]any {
+		a, _ := rsp.GetDesired().GetResources()[name].GetResource().AsMap()["metadata"].(map[string]any)["annotations"].(map[string]any)
+		return a
+	}
+
+	// first reconcile, the resource was never observed and gets imported in observe-only mode
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+	s.NoError(err)
+	s.Len(rsp.Results, 2)
+	s.Contains(rsp.Results[0].GetMessage(), "observe-only mode")
+
+	s.Equal([]any{"Observe"}, managementPolicies(rsp, "securityGroup"))
+	s.Equal("some-external-name", annotations(rsp, "securityGroup")["crossplane.io/external-name"])
+	s.Equal("true", annotations(rsp, "securityGroup")["function-aws-importer.gympass.com/pending-approval"])
+	s.Nil(annotations(rsp, "test-0-ipv4"))
+
+	// following reconciles, the resource is observed with the annotation the function set
+	reqWithPendingApproval := func(xrAnnotations string) *fnv1.RunFunctionRequest {
+		req := s.reqWithObservedExternalName("some-external-name")
+		req.Observed.Resources["securityGroup"].Resource.GetFields()["metadata"].GetStructValue().
+			GetFields()["annotations"].GetStructValue().
+			Fields["function-aws-importer.gympass.com/pending-approval"] = structpb.NewStringValue("true")
+		req.Observed.Composite = &fnv1.Resource{Resource: resource.MustStructJSON(`
+			{
+				"apiVersion": "acme.io/v1beta1",
+				"kind": "XSomeResource",
+				"metadata": {
+					"name": "test",
+					"annotations": ` + xrAnnotations + `
+				}
+			}`)}
+		return req
+	}
+
+	// second reconcile, the resource is not approved yet
+	rsp, err = fn.RunFunction(context.Background(), reqWithPendingApproval(`{}`))
+	s.NoError(err)
+	s.Len(rsp.Results, 3)
+	s.Contains(rsp.Results[0].GetMessage(), "pending approval")
+	// the tag can't reach AWS while the resource is observe-only
+	s.Equal(fnv1.Severity_SEVERITY_WARNING, rsp.Results[1].Severity)
+	s.Equal([]any{"Observe"}, managementPolicies(rsp, "securityGroup"))
+
+	// third reconcile, the resource is approved on the XR
+	rsp, err = fn.RunFunction(context.Background(), reqWithPendingApproval(`{"function-aws-importer.gympass.com/approve-import": "securityGroup"}`))
+	s.NoError(err)
+	s.Len(rsp.Results, 2)
+	s.Contains(rsp.Results[0].GetMessage(), "approved for full management")
+	s.NotContains(rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["spec"], "managementPolicies")
+	s.NotContains(annotations(rsp, "securityGroup"), "function-aws-importer.gympass.com/pending-approval")
+}
+
+func (s *functionSuite) TestRunFunction_ObserveOnlyImportModeAlreadyApproved_ShouldImportWithFullManagement() {
+	s.in.ImportMode = v1beta1.ImportModeObserveOnly
+	s.in.ApprovalPath = "spec.approvedImports"
+
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String("some-external-name"),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+					Value: aws.String("test"),
+				},
+			},
+		}},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+
+	req := s.req()
+	req.Observed = &fnv1.State{Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`
+		{
+			"apiVersion": "acme.io/v1beta1",
+			"kind": "XSomeResource",
+			"metadata": {
+				"name": "test"
+			},
+			"spec": {
+				"approvedImports": ["securityGroup"]
+			}
+		}`)}}
+
+	rsp, err := fn.RunFunction(context.Background(), req)
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+	s.NotContains(rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["spec"], "managementPolicies")
+}
+
+func (s *functionSuite) TestRunFunction_ManagementPoliciesPreventTagUpdates_ShouldWarn() {
+	req := s.reqWithObservedExternalName("some-external-name")
+
+	// can't update tags
+	sgSpec := req.Desired.Resources["securityGroup"].Resource.GetFields()["spec"].GetStructValue()
+	sgSpec.Fields["managementPolicies"] = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+		structpb.NewStringValue("Observe"),
+		structpb.NewStringValue("Create"),
+		structpb.NewStringValue("Delete"),
+	}})
+
+	// tags only defined in initProvider
+	ruleSpec := req.Desired.Resources["test-0-ipv4"].Resource.GetFields()["spec"].GetStructValue()
+	ruleSpec.Fields["initProvider"] = structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+		"tags": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"foo": structpb.NewStringValue("bar"),
+		}}),
+	}})
+
+	fn := &Function{log: logging.NewNopLogger()}
+	rsp, err := fn.RunFunction(context.Background(), req)
+
+	s.NoError(err)
+
+	s.Len(rsp.Results, 2)
+	s.Equalf(fnv1.Severity_SEVERITY_WARNING, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup test-0-ipv4]")
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[1].Severity, "msg: %s", rsp.Results[1].GetMessage())
+
+	spec := func(name string) map[string]any {
+		return rsp.GetDesired().GetResources()[name].GetResource().AsMap()["spec"].(map[string]any)
+	}
+
+	s.Equal("some-external-name", spec("securityGroup")["forProvider"].(map[string]any)["tags"].(map[string]any)[externalNameTag])
+
+	s.Equal(map[string]any{"foo": "bar", externalNameTag: "some-external-name"}, spec("test-0-ipv4")["initProvider"].(map[string]any)["tags"])
+	s.NotContains(spec("test-0-ipv4")["forProvider"], "tags")
+
+	s.Equal("some-external-name", spec("test-1-ipv4")["forProvider"].(map[string]any)["tags"].(map[string]any)[externalNameTag])
+}
+
+func (s *functionSuite) TestRunFunction_ImportedResource_ShouldKeepTagAndAnnotationConsistentAcrossReconciles() {
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String("sg-0ea154g1e2fd170bc"),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+					Value: aws.String("test"),
+				},
+			},
+		}},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+
+	externalNameAndTag := func(rsp *fnv1.RunFunctionResponse, name string) (string, string) {
+		res := rsp.GetDesired().GetResources()[name].GetResource()
+		annotation := res.GetFields()["metadata"].GetStructValue().
+			GetFields()["annotations"].GetStructValue().
+			GetFields()["crossplane.io/external-name"].GetStringValue()
+		tag := res.GetFields()["spec"].GetStructValue().
+			GetFields()["forProvider"].GetStructValue().
+			GetFields()["tags"].GetStructValue().
+			GetFields()[externalNameTag].GetStringValue()
+		return annotation, tag
+	}
+
+	// first reconcile, the resource is imported
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+
+	annotation, tag := externalNameAndTag(rsp, "securityGroup")
+	s.Equal("sg-0ea154g1e2fd170bc", annotation)
+	s.Equal(annotation, tag)
+
+	// resources not found are left untouched
+	annotation, tag = externalNameAndTag(rsp, "test-0-ipv4")
+	s.Empty(annotation)
+	s.Empty(tag)
+
+	// second reconcile, the external name is observed. Previous steps don't set it on desired, only the tag is set
+	req := s.reqWithObservedExternalName("sg-0ea154g1e2fd170bc")
+	rsp, err = fn.RunFunction(context.Background(), req)
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+
+	annotation, tag = externalNameAndTag(rsp, "securityGroup")
+	s.Equal("sg-0ea154g1e2fd170bc", annotation)
+	s.Equal(annotation, tag)
+}
+
+func (s *functionSuite) TestRunFunction_ListShapedTags_ShouldUpsertExternalNameTagEntry() {
+	asgReq := func(desiredTags string) *fnv1.RunFunctionRequest {
+		xr := resource.MustStructJSON(`
+			{
+				"apiVersion": "acme.io/v1beta1",
+				"kind": "XSomeResource",
+				"metadata": {
+					"name": "test"
+				}
+			}`)
+		asg := func(annotations, tags string) *fnv1.Resource {
+			return &fnv1.Resource{Resource: resource.MustStructJSON(`
+				{
+					"apiVersion": "autoscaling.aws.upbound.io/v1beta2",
+					"kind": "AutoscalingGroup",
+					"metadata": {
+						"name": "test",
+						"annotations": {` + annotations + `}
+					},
+					"spec": {
+						"deletionPolicy": "Orphan",
+						"forProvider": {
+							"maxSize": 1,
+							"minSize": 1,
+							"region": "us-east-1",
+							"tag": ` + tags + `
+						}
+					}
+				}`)}
+		}
+		return &fnv1.RunFunctionRequest{
+			Input: resource.MustStructObject(s.in),
+			Desired: &fnv1.State{
+				Resources: map[string]*fnv1.Resource{
+					"someXR": {Resource: xr},
+					"asg":    asg("", desiredTags),
+				},
+			},
+			Observed: &fnv1.State{
+				Composite: &fnv1.Resource{Resource: xr},
+				Resources: map[string]*fnv1.Resource{
+					"asg": asg(`"crossplane.io/external-name": "test-asg"`, `[]`),
+				},
+			},
+		}
+	}
+	tags := func(rsp *fnv1.RunFunctionResponse) any {
+		spec := rsp.GetDesired().GetResources()["asg"].GetResource().AsMap()["spec"].(map[string]any)
+		return spec["forProvider"].(map[string]any)["tag"]
+	}
+
+	fn := &Function{log: logging.NewNopLogger()}
+
+	// the entry is appended and isn't propagated to launched instances
+	rsp, err := fn.RunFunction(context.Background(), asgReq(`[{"key": "Name", "value": "test", "propagateAtLaunch": true}]`))
+	s.NoError(err)
+	s.Equal([]any{
+		map[string]any{"key": "Name", "value": "test", "propagateAtLaunch": true},
+		map[string]any{"key": externalNameTag, "value": "test-asg", "propagateAtLaunch": false},
+	}, tags(rsp))
+
+	// an existing entry is updated instead of duplicated
+	rsp, err = fn.RunFunction(context.Background(), asgReq(`[{"key": "`+externalNameTag+`", "value": "stale", "propagateAtLaunch": false}]`))
+	s.NoError(err)
+	s.Equal([]any{
+		map[string]any{"key": externalNameTag, "value": "test-asg", "propagateAtLaunch": false},
+	}, tags(rsp))
+}
+
+func (s *functionSuite) TestRunFunction_TaggableKindsOverrides_ShouldTagUnknownKindsUnlessDisabled() {
+	widgetReq := func() *fnv1.RunFunctionRequest {
+		widget := func(annotations string) *fnv1.Resource {
+			return &fnv1.Resource{Resource: resource.MustStructJSON(`
+				{
+					"apiVersion": "foo.aws.upbound.io/v1beta1",
+					"kind": "Widget",
+					"metadata": {
+						"name": "test",
+						"annotations": {` + annotations + `}
+					},
+					"spec": {
+						"deletionPolicy": "Orphan",
+						"forProvider": {
+							"region": "us-east-1"
+						}
+					}
+				}`)}
+		}
+		xr := resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)
+		return &fnv1.RunFunctionRequest{
+			Input: resource.MustStructObject(s.in),
+			Desired: &fnv1.State{
+				Resources: map[string]*fnv1.Resource{"someXR": {Resource: xr}, "widget": widget("")},
+			},
+			Observed: &fnv1.State{
+				Composite: &fnv1.Resource{Resource: xr},
+				Resources: map[string]*fnv1.Resource{"widget": widget(`"crossplane.io/external-name": "some-widget"`)},
+			},
+		}
+	}
+	tags := func(rsp *fnv1.RunFunctionResponse) any {
+		spec := rsp.GetDesired().GetResources()["widget"].GetResource().AsMap()["spec"].(map[string]any)
+		return spec["forProvider"].(map[string]any)["tags"]
+	}
+
+	kindsFile := filepath.Join(s.T().TempDir(), "kinds.yaml")
+	s.Require().NoError(os.WriteFile(kindsFile, []byte("- groupKind: Widget.foo.aws.upbound.io\n"), 0o600))
+	kinds, err := loadTaggableKinds(kindsFile)
+	s.Require().NoError(err)
+
+	// unknown kinds aren't tagged
+	fn := &Function{log: logging.NewNopLogger()}
+	rsp, err := fn.RunFunction(context.Background(), widgetReq())
+	s.NoError(err)
+	s.Nil(tags(rsp))
+
+	// unless the function is told they support tags
+	fn.taggableKinds = kinds
+	rsp, err = fn.RunFunction(context.Background(), widgetReq())
+	s.NoError(err)
+	s.Equal(map[string]any{externalNameTag: "some-widget"}, tags(rsp))
+
+	// the input takes precedence
+	s.in.AdditionalTaggableKinds = []v1beta1.TaggableKind{{GroupKind: "widget.foo.aws.upbound.io", Disabled: true}}
+	rsp, err = fn.RunFunction(context.Background(), widgetReq())
+	s.NoError(err)
+	s.Nil(tags(rsp))
+}
+
+func (s *functionSuite) TestLoadTaggableKinds_InvalidKind_ShouldFail() {
+	kindsFile := filepath.Join(s.T().TempDir(), "kinds.yaml")
+	s.Require().NoError(os.WriteFile(kindsFile, []byte("- groupKind: widget.foo.aws.upbound.io\n  tagsShape: Set\n"), 0o600))
+
+	_, err := loadTaggableKinds(kindsFile)
+	s.ErrorContains(err, `invalid tags shape "Set"`)
+}
+
+func (s *functionSuite) TestRunFunction_DiscoverTaggableKinds_ShouldRequireAndCacheCRDs() {
+	s.in.DiscoverTaggableKinds = true
+	const crdKey = "function-aws-importer/crd/widgets.foo.aws.upbound.io"
+
+	widgetReq := func(extra map[string]*fnv1.Resources) *fnv1.RunFunctionRequest {
+		widget := func(annotations string) *fnv1.Resource {
+			return &fnv1.Resource{Resource: resource.MustStructJSON(`
+				{
+					"apiVersion": "foo.aws.upbound.io/v1beta2",
+					"kind": "Widget",
+					"metadata": {
+						"name": "test",
+						"annotations": {` + annotations + `}
+					},
+					"spec": {
+						"deletionPolicy": "Orphan",
+						"forProvider": {
+							"region": "us-east-1"
+						}
+					}
+				}`)}
+		}
+		xr := resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)
+		return &fnv1.RunFunctionRequest{
+			Input: resource.MustStructObject(s.in),
+			Desired: &fnv1.State{
+				Resources: map[string]*fnv1.Resource{"someXR": {Resource: xr}, "widget": widget("")},
+			},
+			Observed: &fnv1.State{
+				Composite: &fnv1.Resource{Resource: xr},
+				Resources: map[string]*fnv1.Resource{"widget": widget(`"crossplane.io/external-name": "some-widget"`)},
+			},
+			ExtraResources: extra,
+		}
+	}
+	tags := func(rsp *fnv1.RunFunctionResponse) any {
+		spec := rsp.GetDesired().GetResources()["widget"].GetResource().AsMap()["spec"].(map[string]any)
+		return spec["forProvider"].(map[string]any)["tags"]
+	}
+	crd := map[string]*fnv1.Resources{crdKey: {Items: []*fnv1.Resource{{Resource: resource.MustStructJSON(`
+		{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind": "CustomResourceDefinition",
+			"metadata": {"name": "widgets.foo.aws.upbound.io"},
+			"spec": {
+				"group": "foo.aws.upbound.io",
+				"names": {"kind": "Widget", "plural": "widgets"},
+				"scope": "Cluster",
+				"versions": [{
+					"name": "v1beta2",
+					"served": true,
+					"storage": true,
+					"schema": {"openAPIV3Schema": {"type": "object", "properties": {"spec": {"type": "object", "properties": {
+						"forProvider": {"type": "object", "properties": {
+							"region": {"type": "string"},
+							"tags": {"type": "object", "additionalProperties": {"type": "string"}}
+						}}
+					}}}}}
+				}]
+			}
+		}`)}}}}
+	wantRequirements := &fnv1.Requirements{ExtraResources: map[string]*fnv1.ResourceSelector{
+		crdKey: {
+			ApiVersion: "apiextensions.k8s.io/v1",
+			Kind:       "CustomResourceDefinition",
+			Match:      &fnv1.ResourceSelector_MatchName{MatchName: "widgets.foo.aws.upbound.io"},
+		},
+	}}
+
+	fn := &Function{log: logging.NewNopLogger(), discovery: newKindDiscovery(time.Hour)}
+
+	// the CRD is required first, nothing else happens
+	rsp, err := fn.RunFunction(context.Background(), widgetReq(nil))
+	s.NoError(err)
+	s.Empty(cmp.Diff(wantRequirements, rsp.GetRequirements(), protocmp.Transform()))
+	s.Nil(tags(rsp))
+
+	// once delivered, the kind is tagged and the requirements are stable
+	rsp, err = fn.RunFunction(context.Background(), widgetReq(crd))
+	s.NoError(err)
+	s.Empty(cmp.Diff(wantRequirements, rsp.GetRequirements(), protocmp.Transform()))
+	s.Equal(map[string]any{externalNameTag: "some-widget"}, tags(rsp))
+
+	// later requests use the cached answer
+	rsp, err = fn.RunFunction(context.Background(), widgetReq(nil))
+	s.NoError(err)
+	s.Nil(rsp.GetRequirements())
+	s.Equal(map[string]any{externalNameTag: "some-widget"}, tags(rsp))
+
+	// without caching, a CRD that doesn't exist falls back to the built-in kinds
+	fn = &Function{log: logging.NewNopLogger()}
+	rsp, err = fn.RunFunction(context.Background(), widgetReq(map[string]*fnv1.Resources{crdKey: {}}))
+	s.NoError(err)
+	s.Empty(cmp.Diff(wantRequirements, rsp.GetRequirements(), protocmp.Transform()))
+	s.Nil(tags(rsp))
+}
+
+func (s *functionSuite) TestRunFunction_ResourcesOfOtherTypesShareTags_ShouldOnlyMatchResourceTypeOfKind() {
+	mapping := func(arn, externalName string) types.ResourceTagMapping {
+		return types.ResourceTagMapping{
+			ResourceARN: aws.String(arn),
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String(externalName),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyName),
+					Value: aws.String("test"),
+				},
+			},
+		}
+	}
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{
+			mapping("arn:aws:ec2:us-east-1:123456789012:vpc/vpc-0ea154g1e2fd170bc", "vpc-0ea154g1e2fd170bc"),
+			mapping("arn:aws:ec2:us-east-1:123456789012:security-group/sg-0ea154g1e2fd170bc", "sg-0ea154g1e2fd170bc"),
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+
+	var resourceTypeFilters [][]string
+	for _, in := range client.Inputs {
+		resourceTypeFilters = append(resourceTypeFilters, in.ResourceTypeFilters)
+	}
+	s.ElementsMatch([][]string{{"ec2:security-group"}, {"ec2:security-group-rule"}, {"ec2:security-group-rule"}}, resourceTypeFilters)
+}
+
+func (s *functionSuite) TestRunFunction_ResourceTaggedWithLegacyKind_ShouldBeFoundByKindAlias() {
+	s.in.KindAliases = []v1beta1.KindAlias{{Group: "ec2.aws.m.upbound.io", Aliases: []string{"ec2.aws.upbound.io"}}}
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String("sg-0ea154g1e2fd170bc"),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyKind),
+					Value: aws.String("securitygroup.ec2.aws.upbound.io"),
+				},
+			},
+		}},
+	}
+
+	req := s.req()
+	req.Desired.Resources["securityGroup"].Resource.Fields["apiVersion"] = structpb.NewStringValue("ec2.aws.m.upbound.io/v1beta1")
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+	rsp, err := fn.RunFunction(context.Background(), req)
+
+	s.NoError(err)
+	s.Len(rsp.Results, 2)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup (securitygroup.ec2.aws.upbound.io)]")
+
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+
+	// the actual group-kind is tried first
+	var kinds []string
+	for _, in := range client.Inputs {
+		for _, tf := range in.TagFilters {
+			if aws.ToString(tf.Key) == runtimeresource.ExternalResourceTagKeyKind && strings.HasPrefix(tf.Values[0], "securitygroup.") {
+				kinds = append(kinds, tf.Values[0])
+			}
+		}
+	}
+	s.Equal([]string{"securitygroup.ec2.aws.m.upbound.io", "securitygroup.ec2.aws.upbound.io"}, kinds)
+}
+
+func (s *functionSuite) TestRunFunction_ClassicProviderResources_ShouldBeImportedAndTaggedInTheirFormat() {
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			ResourceARN: aws.String("arn:aws:ec2:us-east-1:123456789012:security-group/sg-0ea154g1e2fd170bc"),
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String("sg-0ea154g1e2fd170bc"),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyKind),
+					Value: aws.String("securitygroup.ec2.aws.crossplane.io"),
+				},
+			},
+		}},
+	}
+
+	sg := resource.MustStructJSON(`
+		{
+			"apiVersion": "ec2.aws.crossplane.io/v1beta1",
+			"kind": "SecurityGroup",
+			"metadata": {
+				"name": "test"
+			},
+			"spec": {
+				"deletionPolicy": "Orphan",
+				"forProvider": {
+					"groupName": "test",
+					"region": "us-east-1",
+					"tags": [{"key": "Name", "value": "test"}]
+				}
+			}
+		}`)
+	key := func(annotations string) *structpb.Struct {
+		return resource.MustStructJSON(`
+			{
+				"apiVersion": "kms.aws.crossplane.io/v1alpha1",
+				"kind": "Key",
+				"metadata": {
+					"name": "test",
+					"annotations": {` + annotations + `}
+				},
+				"spec": {
+					"deletionPolicy": "Orphan",
+					"forProvider": {
+						"region": "us-east-1"
+					}
+				}
+			}`)
+	}
+	xr := resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)
+	forProvider := func(rsp *fnv1.RunFunctionResponse, name string) map[string]any {
+		spec := rsp.GetDesired().GetResources()[name].GetResource().AsMap()["spec"].(map[string]any)
+		return spec["forProvider"].(map[string]any)
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client}
+
+	// the security group is imported, and tagged as a list of key-value objects
+	rsp, err := fn.RunFunction(context.Background(), &fnv1.RunFunctionRequest{
+		Input: resource.MustStructObject(s.in),
+		Desired: &fnv1.State{
+			Resources: map[string]*fnv1.Resource{"someXR": {Resource: xr}, "securityGroup": {Resource: sg}},
+		},
+		Observed: &fnv1.State{Composite: &fnv1.Resource{Resource: xr}},
+	})
+	s.NoError(err)
+
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+	s.Equal([]any{
+		map[string]any{"key": "Name", "value": "test"},
+		map[string]any{"key": externalNameTag, "value": "sg-0ea154g1e2fd170bc"},
+	}, forProvider(rsp, "securityGroup")["tags"])
+
+	s.Require().Len(client.Inputs, 1)
+	s.Equal([]string{"ec2:security-group"}, client.Inputs[0].ResourceTypeFilters)
+
+	// the key's tag entries have different field names
+	rsp, err = fn.RunFunction(context.Background(), &fnv1.RunFunctionRequest{
+		Input: resource.MustStructObject(s.in),
+		Desired: &fnv1.State{
+			Resources: map[string]*fnv1.Resource{"someXR": {Resource: xr}, "key": {Resource: key("")}},
+		},
+		Observed: &fnv1.State{
+			Composite: &fnv1.Resource{Resource: xr},
+			Resources: map[string]*fnv1.Resource{"key": {Resource: key(`"crossplane.io/external-name": "some-key-id"`)}},
+		},
+	})
+	s.NoError(err)
+
+	s.Equal([]any{
+		map[string]any{"tagKey": externalNameTag, "tagValue": "some-key-id"},
+	}, forProvider(rsp, "key")["tags"])
+}
+
+func (s *functionSuite) TestRunFunction_SecurityGroupWithoutTags_ShouldBeFoundByNaturalKey() {
+	securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{
+		SecurityGroups: []ec2types.SecurityGroup{
+			{GroupId: aws.String("sg-0ea154g1e2fd170bc"), GroupName: aws.String("test"), VpcId: aws.String("some-vpc-id")},
+			{GroupId: aws.String("sg-1111111111111111"), GroupName: aws.String("test"), VpcId: aws.String("other-vpc-id")},
+			{GroupId: aws.String("sg-2222222222222222"), GroupName: aws.String("other"), VpcId: aws.String("some-vpc-id")},
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, securityGroups: securityGroups}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Len(rsp.Results, 2)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup (SecurityGroupNaturalKey)]")
+
+	sg := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()
+	s.Equal("sg-0ea154g1e2fd170bc", sg["metadata"].(map[string]any)["annotations"].(map[string]any)["crossplane.io/external-name"])
+	s.Equal("sg-0ea154g1e2fd170bc", sg["spec"].(map[string]any)["forProvider"].(map[string]any)["tags"].(map[string]any)[externalNameTag])
+
+	// other kinds aren't looked up by natural key
+	s.Len(securityGroups.Inputs, 1)
+}
+
+func (s *functionSuite) TestRunFunction_SecurityGroupWithKindAliases_ShouldBeLookedUpByNaturalKeyOnce() {
+	s.in.KindAliases = []v1beta1.KindAlias{{Group: "ec2.aws.upbound.io", Aliases: []string{"ec2.aws.legacy.io", "ec2.aws.older.io"}}}
+	client := &test.FakeGetResourcesAPIClient{}
+	securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client, securityGroups: securityGroups}
+	_, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Len(securityGroups.Inputs, 1)
+
+	// the Tagging API is still tried with each alias
+	var kinds []string
+	for _, in := range client.Inputs {
+		for _, tf := range in.TagFilters {
+			if aws.ToString(tf.Key) == runtimeresource.ExternalResourceTagKeyKind && strings.HasPrefix(tf.Values[0], "securitygroup.") {
+				kinds = append(kinds, tf.Values[0])
+			}
+		}
+	}
+	s.Equal([]string{"securitygroup.ec2.aws.upbound.io", "securitygroup.ec2.aws.legacy.io", "securitygroup.ec2.aws.older.io"}, kinds)
+}
+
+func (s *functionSuite) TestRunFunction_SecurityGroupFoundByTags_ShouldNotBeLookedUpByNaturalKey() {
+	client := &test.FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{{
+			Tags: []types.Tag{
+				{
+					Key:   aws.String(externalNameTag),
+					Value: aws.String("sg-0ea154g1e2fd170bc"),
+				},
+				{
+					Key:   aws.String(runtimeresource.ExternalResourceTagKeyKind),
+					Value: aws.String("securitygroup.ec2.aws.upbound.io"),
+				},
+			},
+		}},
+	}
+	securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client, securityGroups: securityGroups}
+	_, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Empty(securityGroups.Inputs)
+}
+
+func (s *functionSuite) TestRunFunction_SecurityGroupNaturalKeyMatchesSeveral_ShouldFailUnlessExcluded() {
+	securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{
+		SecurityGroups: []ec2types.SecurityGroup{
+			{GroupId: aws.String("sg-0ea154g1e2fd170bc"), GroupName: aws.String("test"), VpcId: aws.String("some-vpc-id")},
+			{
+				GroupId:   aws.String("sg-1111111111111111"),
+				GroupName: aws.String("test"),
+				VpcId:     aws.String("some-vpc-id"),
+				Tags:      []ec2types.Tag{{Key: aws.String("env"), Value: aws.String("old")}},
+			},
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, securityGroups: securityGroups}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "sg-1111111111111111")
+
+	s.in.ExcludeTagFilters = []v1beta1.TagFilter{{Key: "env", Strategy: v1beta1.StrategyValue, Value: "old"}}
+	rsp, err = fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+}
+
+func (s *functionSuite) TestRunFunction_SecurityGroupNaturalKey_ShouldSkipSecurityGroupsTaggedAsOtherResources() {
+	s.in.KindAliases = []v1beta1.KindAlias{{Group: "ec2.aws.upbound.io", Aliases: []string{"ec2.aws.legacy.io"}}}
+	crossplaneTags := func(name, kind string) []ec2types.Tag {
+		return []ec2types.Tag{
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String(name)},
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyKind), Value: aws.String(kind)},
+		}
+	}
+	securityGroup := func(id string, tags []ec2types.Tag) ec2types.SecurityGroup {
+		return ec2types.SecurityGroup{GroupId: aws.String(id), GroupName: aws.String("test"), VpcId: aws.String("some-vpc-id"), Tags: tags}
+	}
+	externalName := func(rsp *fnv1.RunFunctionResponse) any {
+		annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+		if annotations == nil {
+			return nil
+		}
+		return annotations.(map[string]any)["crossplane.io/external-name"]
+	}
+
+	cases := map[string]struct {
+		tags         []ec2types.Tag
+		externalName any
+	}{
+		"tagged as the resource": {
+			tags:         crossplaneTags("test", "securitygroup.ec2.aws.upbound.io"),
+			externalName: "sg-0ea154g1e2fd170bc",
+		},
+		"tagged as the resource with a kind alias": {
+			tags:         crossplaneTags("test", "securitygroup.ec2.aws.legacy.io"),
+			externalName: "sg-0ea154g1e2fd170bc",
+		},
+		"tagged with another name": {
+			tags: crossplaneTags("other", "securitygroup.ec2.aws.upbound.io"),
+		},
+		"tagged with another kind": {
+			tags: crossplaneTags("test", "securitygroup.ec2.aws.crossplane.io"),
+		},
+	}
+	for name, tc := range cases {
+		s.Run(name, func() {
+			securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{
+				SecurityGroups: []ec2types.SecurityGroup{securityGroup("sg-0ea154g1e2fd170bc", tc.tags)},
+			}
+
+			fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, securityGroups: securityGroups}
+			rsp, err := fn.RunFunction(context.Background(), s.req())
+
+			s.NoError(err)
+			s.Equal(tc.externalName, externalName(rsp))
+		})
+	}
+}
+
+func (s *functionSuite) TestRunFunction_HostedZoneWithoutTags_ShouldBeFoundByNaturalKeyUnlessAmbiguous() {
+	zoneReq := func(vpc string) *fnv1.RunFunctionRequest {
+		return &fnv1.RunFunctionRequest{
+			Input: resource.MustStructObject(s.in),
+			Desired: &fnv1.State{
+				Resources: map[string]*fnv1.Resource{
+					"zone": {Resource: resource.MustStructJSON(`
+						{
+							"apiVersion": "route53.aws.upbound.io/v1beta1",
+							"kind": "Zone",
+							"metadata": {
+								"name": "test"
+							},
+							"spec": {
+								"deletionPolicy": "Orphan",
+								"forProvider": {
+									"name": "Example.com",
+									"region": "us-east-1"` + vpc + `
+								}
+							}
+						}`)},
+				},
+			},
+			Observed: &fnv1.State{
+				Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)},
+			},
+		}
+	}
+	privateZone := func(id, name string) route53types.HostedZone {
+		return route53types.HostedZone{Id: aws.String(id), Name: aws.String(name), Config: &route53types.HostedZoneConfig{PrivateZone: true}}
+	}
+	publicZone := func(id, name string) route53types.HostedZone {
+		return route53types.HostedZone{Id: aws.String(id), Name: aws.String(name), Config: &route53types.HostedZoneConfig{}}
+	}
+	externalName := func(rsp *fnv1.RunFunctionResponse) any {
+		annotations := rsp.GetDesired().GetResources()["zone"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+		if annotations == nil {
+			return nil
+		}
+		return annotations.(map[string]any)["crossplane.io/external-name"]
+	}
+
+	hostedZones := &test.FakeRoute53HostedZonesAPIClient{
+		HostedZones: []route53types.HostedZone{
+			publicZone("/hostedzone/Z0000000000000000001", "a.example.com."),
+			privateZone("/hostedzone/Z0000000000000000002", "example.com."),
+			publicZone("/hostedzone/Z0000000000000000003", "example.com."),
+			publicZone("/hostedzone/Z0000000000000000004", "example.com.br."),
+		},
+		VPCs:     map[string][]string{"/hostedzone/Z0000000000000000002": {"some-vpc-id"}},
+		PageSize: 1,
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, hostedZones: hostedZones}
+
+	s.Run("public zone", func() {
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(""))
+
+		s.NoError(err)
+		s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+		s.Equal("Z0000000000000000003", externalName(rsp))
+	})
+
+	s.Run("private zone", func() {
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(`, "vpc": [{"vpcId": "some-vpc-id"}]`))
+
+		s.NoError(err)
+		s.Equal("Z0000000000000000002", externalName(rsp))
+	})
+
+	s.Run("private zone associated with another VPC", func() {
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(`, "vpc": [{"vpcId": "other-vpc-id", "vpcRegion": "us-west-2"}]`))
+
+		s.NoError(err)
+		s.Nil(externalName(rsp))
+	})
+
+	s.Run("private zone whose VPC ID isn't resolved yet", func() {
+		// a public zone has the same name, but mustn't be picked for a private one
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(`, "vpc": [{"vpcIdRef": {"name": "some-vpc"}}]`))
+
+		s.NoError(err)
+		s.Nil(externalName(rsp))
+	})
+
+	s.Run("several public zones share the name", func() {
+		hostedZones.HostedZones = append(hostedZones.HostedZones, publicZone("/hostedzone/Z0000000000000000005", "example.com."))
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(""))
+
+		s.NoError(err)
+		s.Len(rsp.Results, 1)
+		s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+		s.Contains(rsp.Results[0].GetMessage(), "[Z0000000000000000003 Z0000000000000000005]")
+		s.Nil(externalName(rsp))
+	})
+
+	s.Run("public zone sharing the name is tagged as another resource", func() {
+		hostedZones.Tags = map[string][]route53types.Tag{
+			"/hostedzone/Z0000000000000000005": {
+				{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String("other")},
+				{Key: aws.String(runtimeresource.ExternalResourceTagKeyKind), Value: aws.String("zone.route53.aws.upbound.io")},
+			},
+		}
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(""))
+
+		s.NoError(err)
+		s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+		s.Equal("Z0000000000000000003", externalName(rsp))
+	})
+}
+
+func (s *functionSuite) TestRunFunction_ClassicHostedZone_ShouldBeFoundByNaturalKey() {
+	zoneReq := func(vpc string) *fnv1.RunFunctionRequest {
+		return &fnv1.RunFunctionRequest{
+			Input: resource.MustStructObject(s.in),
+			Desired: &fnv1.State{
+				Resources: map[string]*fnv1.Resource{
+					"zone": {Resource: resource.MustStructJSON(`
+						{
+							"apiVersion": "route53.aws.crossplane.io/v1alpha1",
+							"kind": "HostedZone",
+							"metadata": {
+								"name": "test"
+							},
+							"spec": {
+								"deletionPolicy": "Orphan",
+								"forProvider": {
+									"name": "example.com"` + vpc + `
+								}
+							}
+						}`)},
+				},
+			},
+			Observed: &fnv1.State{
+				Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)},
+			},
+		}
+	}
+	externalName := func(rsp *fnv1.RunFunctionResponse) any {
+		annotations := rsp.GetDesired().GetResources()["zone"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+		if annotations == nil {
+			return nil
+		}
+		return annotations.(map[string]any)["crossplane.io/external-name"]
+	}
+
+	hostedZones := &test.FakeRoute53HostedZonesAPIClient{
+		HostedZones: []route53types.HostedZone{
+			{Id: aws.String("/hostedzone/Z0000000000000000001"), Name: aws.String("example.com."), Config: &route53types.HostedZoneConfig{PrivateZone: true}},
+			{Id: aws.String("/hostedzone/Z0000000000000000002"), Name: aws.String("example.com."), Config: &route53types.HostedZoneConfig{}},
+		},
+		VPCs: map[string][]string{"/hostedzone/Z0000000000000000001": {"some-vpc-id"}},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, hostedZones: hostedZones}
+
+	s.Run("public zone", func() {
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(""))
+
+		s.NoError(err)
+		s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+		s.Equal("Z0000000000000000002", externalName(rsp))
+	})
+
+	s.Run("private zone", func() {
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(`, "vpc": {"vpcId": "some-vpc-id", "vpcRegion": "us-east-1"}`))
+
+		s.NoError(err)
+		s.Equal("Z0000000000000000001", externalName(rsp))
+	})
+
+	s.Run("private zone without VPC region", func() {
+		rsp, err := fn.RunFunction(context.Background(), zoneReq(`, "vpc": {"vpcId": "some-vpc-id"}`))
+
+		s.NoError(err)
+		s.Nil(externalName(rsp))
+	})
+}
+
+func (s *functionSuite) TestRunFunction_FinderChain_ShouldOnlyUseFindersOfTheChainInOrder() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "securitygroup.ec2.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderSecurityGroupNaturalKey},
+	}}
+	client := &test.FakeGetResourcesAPIClient{}
+	securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{
+		SecurityGroups: []ec2types.SecurityGroup{
+			{GroupId: aws.String("sg-0ea154g1e2fd170bc"), GroupName: aws.String("test"), VpcId: aws.String("some-vpc-id")},
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client, securityGroups: securityGroups}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+
+	// other kinds still use the default chain
+	for _, in := range client.Inputs {
+		for _, tf := range in.TagFilters {
+			if aws.ToString(tf.Key) == runtimeresource.ExternalResourceTagKeyKind {
+				s.NotEqual("securitygroup.ec2.aws.upbound.io", tf.Values[0])
+			}
+		}
+	}
+	s.NotEmpty(client.Inputs)
+}
+
+func (s *functionSuite) TestRunFunction_DefaultFinderNotAllowed_ShouldBeSkippedUnlessInChain() {
+	securityGroups := &test.FakeDescribeSecurityGroupsAPIClient{
+		Err: &smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "You are not authorized to perform this operation."},
+	}
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, securityGroups: securityGroups}
+
+	s.Run("default chain", func() {
+		rsp, err := fn.RunFunction(context.Background(), s.req())
+
+		s.NoError(err)
+		s.Len(rsp.Results, 2)
+		s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+		s.Contains(rsp.Results[0].GetMessage(), "external resources not found")
+		s.Contains(rsp.Results[0].GetMessage(), "securityGroup")
+		s.NotEmpty(securityGroups.Inputs)
+	})
+
+	s.Run("explicit chain", func() {
+		s.in.FinderChains = []v1beta1.FinderChain{{
+			GroupKind: "securitygroup.ec2.aws.upbound.io",
+			Finders:   []v1beta1.Finder{v1beta1.FinderTaggingAPI, v1beta1.FinderSecurityGroupNaturalKey},
+		}}
+		rsp, err := fn.RunFunction(context.Background(), s.req())
+
+		s.NoError(err)
+		s.Len(rsp.Results, 1)
+		s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+		s.Contains(rsp.Results[0].GetMessage(), "UnauthorizedOperation")
+	})
+}
+
+func (s *functionSuite) TestRunFunction_FinderChainWithDisabledFinder_ShouldFail() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		Group:   "ec2.aws.upbound.io",
+		Finders: []v1beta1.Finder{v1beta1.FinderTaggingAPI, v1beta1.FinderSecurityGroupNaturalKey},
+	}}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Len(rsp.Results, 1)
+	s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), `finder "SecurityGroupNaturalKey" is not enabled`)
+}
+
+func (s *functionSuite) TestRunFunction_CloudControlFinder_ShouldMatchTagsInResourceProperties() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "securitygroup.ec2.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderCloudControl},
+	}}
+	cloudControl := &test.FakeCloudControlAPIClient{
+		Resources: map[string][]cloudcontroltypes.ResourceDescription{
+			"AWS::EC2::SecurityGroup": {
+				// another resource's tags
+				{
+					Identifier: aws.String("sg-1111111111111111"),
+					Properties: aws.String(`{"GroupId": "sg-1111111111111111", "Tags": [{"Key": "crossplane-name", "Value": "other"}, {"Key": "crossplane-kind", "Value": "securitygroup.ec2.aws.upbound.io"}]}`),
+				},
+				// no tags
+				{
+					Identifier: aws.String("sg-2222222222222222"),
+					Properties: aws.String(`{"GroupId": "sg-2222222222222222"}`),
+				},
+				{
+					Identifier: aws.String("sg-0ea154g1e2fd170bc"),
+					Properties: aws.String(`{"GroupId": "sg-0ea154g1e2fd170bc", "Tags": [{"Key": "crossplane-name", "Value": "test"}, {"Key": "crossplane-kind", "Value": "securitygroup.ec2.aws.upbound.io"}]}`),
+				},
+			},
+		},
+		PageSize: 2,
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, cloudControl: cloudControl}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup (CloudControl)]")
+
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+	s.Len(cloudControl.Inputs, 2)
+}
+
+func (s *functionSuite) TestCloudControlTags_ShouldSupportListsAndMaps() {
+	tags, err := cloudControlTags(`{"Tags": [{"Key": "b", "Value": "2"}, {"Key": "a", "Value": "1"}]}`)
+	s.NoError(err)
+	s.Equal([]types.Tag{{Key: aws.String("b"), Value: aws.String("2")}, {Key: aws.String("a"), Value: aws.String("1")}}, tags)
+
+	tags, err = cloudControlTags(`{"Tags": {"b": "2", "a": "1"}}`)
+	s.NoError(err)
+	s.Equal([]types.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("2")}}, tags)
+
+	tags, err = cloudControlTags(`{"Id": "some-id"}`)
+	s.NoError(err)
+	s.Empty(tags)
+
+	_, err = cloudControlTags(`{"Tags": "nope"}`)
+	s.Error(err)
+}
+
+func (s *functionSuite) TestRunFunction_AWSConfigFinder_ShouldMatchTagPairsInTheResourceRegion() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "securitygroup.ec2.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderAWSConfig},
+	}}
+	result := func(region, id string, tags ...string) map[string]any {
+		var tagList []any
+		for i := 0; i < len(tags); i += 2 {
+			tagList = append(tagList, map[string]any{"tag": tags[i] + "=" + tags[i+1], "key": tags[i], "value": tags[i+1]})
+		}
+		return map[string]any{
+			"accountId":    "123456789012",
+			"awsRegion":    region,
+			"arn":          "arn:aws:ec2:" + region + ":123456789012:security-group/" + id,
+			"resourceId":   id,
+			"resourceType": "AWS::EC2::SecurityGroup",
+			"tags":         tagList,
+		}
+	}
+	awsConfig := &test.FakeSelectAggregateResourceConfigAPIClient{
+		Results: [][]map[string]any{
+			{
+				// same resource in another region
+				result("us-west-2", "sg-1111111111111111", "crossplane-name", "test", "crossplane-kind", "securitygroup.ec2.aws.upbound.io"),
+				// keys and values match, but not in pairs
+				result("us-east-1", "sg-2222222222222222", "crossplane-name", "securitygroup.ec2.aws.upbound.io", "crossplane-kind", "test"),
+			},
+			{
+				result("us-east-1", "sg-0ea154g1e2fd170bc", "crossplane-name", "test", "crossplane-kind", "securitygroup.ec2.aws.upbound.io"),
+			},
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, awsConfig: awsConfig, awsConfigAggregator: "some-aggregator"}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[securityGroup (AWSConfig, account 123456789012, region us-east-1)]")
+
+	// the external name is taken from the ARN, as the external-name tag is missing
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+
+	s.Require().Len(awsConfig.Inputs, 2)
+	s.Equal("some-aggregator", aws.ToString(awsConfig.Inputs[0].ConfigurationAggregatorName))
+	s.Equal("SELECT accountId, awsRegion, arn, resourceId, resourceType, tags WHERE tags.key = 'crossplane-name' AND tags.value = 'test' AND tags.key = 'crossplane-kind' AND tags.value = 'securitygroup.ec2.aws.upbound.io' AND resourceType = 'AWS::EC2::SecurityGroup'",
+		aws.ToString(awsConfig.Inputs[0].Expression))
+}
+
+func (s *functionSuite) TestRunFunction_AWSConfigFinderWithAccount_ShouldIgnoreResourcesOfOtherAccounts() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "securitygroup.ec2.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderAWSConfig},
+	}}
+	result := func(accountID, id string) map[string]any {
+		return map[string]any{
+			"accountId":    accountID,
+			"awsRegion":    "us-east-1",
+			"arn":          "arn:aws:ec2:us-east-1:" + accountID + ":security-group/" + id,
+			"resourceId":   id,
+			"resourceType": "AWS::EC2::SecurityGroup",
+			"tags": []any{
+				map[string]any{"key": "crossplane-name", "value": "test"},
+				map[string]any{"key": "crossplane-kind", "value": "securitygroup.ec2.aws.upbound.io"},
+			},
+		}
+	}
+	awsConfig := &test.FakeSelectAggregateResourceConfigAPIClient{
+		Results: [][]map[string]any{{
+			result("210987654321", "sg-1111111111111111"),
+			result("123456789012", "sg-0ea154g1e2fd170bc"),
+		}},
+	}
+
+	fn := &Function{
+		log:                 logging.NewNopLogger(),
+		client:              &test.FakeGetResourcesAPIClient{},
+		awsConfig:           awsConfig,
+		awsConfigAggregator: "some-aggregator",
+		awsConfigAccountID:  "123456789012",
+	}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	annotations := rsp.GetDesired().GetResources()["securityGroup"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"]
+	s.Equal("sg-0ea154g1e2fd170bc", annotations.(map[string]any)["crossplane.io/external-name"])
+}
+
+func (s *functionSuite) TestRunFunction_AWSConfigFinderWithQuotedKindAlias_ShouldFail() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "securitygroup.ec2.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderAWSConfig},
+	}}
+	s.in.KindAliases = []v1beta1.KindAlias{{Group: "ec2.aws.upbound.io", Aliases: []string{"ec2.aws.upbound.io' OR 'a' = 'a"}}}
+	awsConfig := &test.FakeSelectAggregateResourceConfigAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, awsConfig: awsConfig, awsConfigAggregator: "some-aggregator"}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "it contains quotes")
+	for _, in := range awsConfig.Inputs {
+		s.NotContains(aws.ToString(in.Expression), "OR")
+	}
+}
+
+func (s *functionSuite) TestRunFunction_AWSConfigFinder_ShouldQueryAWSConfigResourceType() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "policy.iam.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderAWSConfig},
+	}}
+	req := &fnv1.RunFunctionRequest{
+		Input: resource.MustStructObject(s.in),
+		Desired: &fnv1.State{
+			Resources: map[string]*fnv1.Resource{
+				"policy": {Resource: resource.MustStructJSON(`{"apiVersion": "iam.aws.upbound.io/v1beta1", "kind": "Policy", "metadata": {"name": "test"}, "spec": {"deletionPolicy": "Orphan", "forProvider": {}}}`)},
+			},
+		},
+		Observed: &fnv1.State{
+			Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)},
+		},
+	}
+	awsConfig := &test.FakeSelectAggregateResourceConfigAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, awsConfig: awsConfig, awsConfigAggregator: "some-aggregator"}
+	_, err := fn.RunFunction(context.Background(), req)
+
+	s.NoError(err)
+	s.Require().NotEmpty(awsConfig.Inputs)
+	// the CloudFormation type is AWS::IAM::ManagedPolicy
+	s.Contains(aws.ToString(awsConfig.Inputs[0].Expression), "resourceType = 'AWS::IAM::Policy'")
+}
+
+func (s *functionSuite) TestRunFunction_AWSConfigFinderWithoutAggregator_ShouldNotBeEnabled() {
+	s.in.FinderChains = []v1beta1.FinderChain{{
+		GroupKind: "securitygroup.ec2.aws.upbound.io",
+		Finders:   []v1beta1.Finder{v1beta1.FinderAWSConfig},
+	}}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, awsConfig: &test.FakeSelectAggregateResourceConfigAPIClient{}}
+	rsp, err := fn.RunFunction(context.Background(), s.req())
+
+	s.NoError(err)
+	s.Equalf(fnv1.Severity_SEVERITY_FATAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), `finder "AWSConfig" is not enabled`)
+}
+
+func (s *functionSuite) TestRunFunction_IAMResources_ShouldBeFoundWithIAMByDefault() {
+	mr := func(kind, name string) *fnv1.Resource {
+		return &fnv1.Resource{Resource: resource.MustStructJSON(`
+			{
+				"apiVersion": "iam.aws.upbound.io/v1beta1",
+				"kind": "` + kind + `",
+				"metadata": {
+					"name": "` + name + `"
+				},
+				"spec": {
+					"deletionPolicy": "Orphan",
+					"forProvider": {}
+				}
+			}`)}
+	}
+	crossplaneTags := func(name, kind string) []iamtypes.Tag {
+		return []iamtypes.Tag{
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String(name)},
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyKind), Value: aws.String(kind)},
+		}
+	}
+	iamClient := &test.FakeIAMAPIClient{
+		Roles: []iamtypes.Role{
+			{RoleName: aws.String("other-role"), Arn: aws.String("arn:aws:iam::123456789012:role/other-role")},
+			{RoleName: aws.String("some-role"), Arn: aws.String("arn:aws:iam::123456789012:role/some-role")},
+		},
+		Policies: []iamtypes.Policy{
+			{PolicyName: aws.String("some-policy"), Arn: aws.String("arn:aws:iam::123456789012:policy/some-policy")},
+		},
+		Tags: map[string][]iamtypes.Tag{
+			"other-role": crossplaneTags("other", "role.iam.aws.upbound.io"),
+			"some-role":  crossplaneTags("test-role", "role.iam.aws.upbound.io"),
+			"arn:aws:iam::123456789012:policy/some-policy": crossplaneTags("test-policy", "policy.iam.aws.upbound.io"),
+		},
+	}
+	client := &test.FakeGetResourcesAPIClient{}
+
+	fn := &Function{log: logging.NewNopLogger(), client: client, iam: iamClient}
+	rsp, err := fn.RunFunction(context.Background(), &fnv1.RunFunctionRequest{
+		Input: resource.MustStructObject(s.in),
+		Desired: &fnv1.State{
+			Resources: map[string]*fnv1.Resource{
+				"role":   mr("Role", "test-role"),
+				"policy": mr("Policy", "test-policy"),
+			},
+		},
+		Observed: &fnv1.State{
+			Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)},
+		},
+	})
+
+	s.NoError(err)
+	s.Equalf(fnv1.Severity_SEVERITY_NORMAL, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+	s.Contains(rsp.Results[0].GetMessage(), "[policy (IAM) role (IAM)]")
+
+	externalName := func(name string) any {
+		return rsp.GetDesired().GetResources()[name].GetResource().AsMap()["metadata"].(map[string]any)["annotations"].(map[string]any)["crossplane.io/external-name"]
+	}
+	s.Equal("some-role", externalName("role"))
+	s.Equal("arn:aws:iam::123456789012:policy/some-policy", externalName("policy"))
+
+	// found before falling back to the Tagging API
+	s.Empty(client.Inputs)
+}
+
+func (s *functionSuite) TestRunFunction_IAMFinder_ShouldGetEntitiesByNameBeforeListingThemOnce() {
+	s.in.KindAliases = []v1beta1.KindAlias{{Group: "iam.aws.upbound.io", Aliases: []string{"iam.aws.other.io", "iam.aws.legacy.io"}}}
+	roleReq := &fnv1.RunFunctionRequest{
+		Input: resource.MustStructObject(s.in),
+		Desired: &fnv1.State{
+			Resources: map[string]*fnv1.Resource{
+				"role": {Resource: resource.MustStructJSON(`{"apiVersion": "iam.aws.upbound.io/v1beta1", "kind": "Role", "metadata": {"name": "test-role"}, "spec": {"deletionPolicy": "Orphan", "forProvider": {}}}`)},
+			},
+		},
+		Observed: &fnv1.State{
+			Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)},
+		},
+	}
+	crossplaneTags := func(kind string) []iamtypes.Tag {
+		return []iamtypes.Tag{
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String("test-role")},
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyKind), Value: aws.String(kind)},
+		}
+	}
+	externalName := func(rsp *fnv1.RunFunctionResponse) any {
+		return rsp.GetDesired().GetResources()["role"].GetResource().AsMap()["metadata"].(map[string]any)["annotations"].(map[string]any)["crossplane.io/external-name"]
+	}
+
+	s.Run("named like the resource", func() {
+		iamClient := &test.FakeIAMAPIClient{
+			Roles: []iamtypes.Role{{RoleName: aws.String("test-role"), Arn: aws.String("arn:aws:iam::123456789012:role/test-role")}},
+			Tags:  map[string][]iamtypes.Tag{"test-role": crossplaneTags("role.iam.aws.upbound.io")},
+		}
+
+		fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, iam: iamClient}
+		rsp, err := fn.RunFunction(context.Background(), roleReq)
+
+		s.NoError(err)
+		s.Equal("test-role", externalName(rsp))
+		s.Equal([]string{"GetRole"}, iamClient.Calls)
+	})
+
+	s.Run("named otherwise and tagged with a kind alias", func() {
+		iamClient := &test.FakeIAMAPIClient{
+			Roles: []iamtypes.Role{{RoleName: aws.String("some-role"), Arn: aws.String("arn:aws:iam::123456789012:role/some-role")}},
+			Tags:  map[string][]iamtypes.Tag{"some-role": crossplaneTags("role.iam.aws.legacy.io")},
+		}
+
+		fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, iam: iamClient}
+		rsp, err := fn.RunFunction(context.Background(), roleReq)
+
+		s.NoError(err)
+		s.Equal("some-role", externalName(rsp))
+		// looked up with each alias, but fetched once
+		s.Equal([]string{"GetRole", "ListRoles", "ListRoleTags"}, iamClient.Calls)
+	})
+}
+
+func (s *functionSuite) TestRunFunction_IAMFinder_ShouldListEntitiesOnceForAllResources() {
+	role := func(name string) *fnv1.Resource {
+		return &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "iam.aws.upbound.io/v1beta1", "kind": "Role", "metadata": {"name": "` + name + `"}, "spec": {"deletionPolicy": "Orphan", "forProvider": {}}}`)}
+	}
+	crossplaneTags := func(name string) []iamtypes.Tag {
+		return []iamtypes.Tag{
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyName), Value: aws.String(name)},
+			{Key: aws.String(runtimeresource.ExternalResourceTagKeyKind), Value: aws.String("role.iam.aws.upbound.io")},
+		}
+	}
+	iamClient := &test.FakeIAMAPIClient{
+		Roles: []iamtypes.Role{
+			{RoleName: aws.String("some-role"), Arn: aws.String("arn:aws:iam::123456789012:role/some-role")},
+			{RoleName: aws.String("other-role"), Arn: aws.String("arn:aws:iam::123456789012:role/other-role")},
+		},
+		Tags: map[string][]iamtypes.Tag{
+			"some-role":  crossplaneTags("test-role"),
+			"other-role": crossplaneTags("test-other-role"),
+		},
+	}
+
+	fn := &Function{log: logging.NewNopLogger(), client: &test.FakeGetResourcesAPIClient{}, iam: iamClient}
+	rsp, err := fn.RunFunction(context.Background(), &fnv1.RunFunctionRequest{
+		Input: resource.MustStructObject(s.in),
+		Desired: &fnv1.State{
+			Resources: map[string]*fnv1.Resource{
+				"role":      role("test-role"),
+				"otherRole": role("test-other-role"),
+			},
+		},
+		Observed: &fnv1.State{
+			Composite: &fnv1.Resource{Resource: resource.MustStructJSON(`{"apiVersion": "acme.io/v1beta1", "kind": "XSomeResource", "metadata": {"name": "test"}}`)},
+		},
+	})
+
+	s.NoError(err)
+	externalName := func(name string) any {
+		return rsp.GetDesired().GetResources()[name].GetResource().AsMap()["metadata"].(map[string]any)["annotations"].(map[string]any)["crossplane.io/external-name"]
+	}
+	s.Equal("some-role", externalName("role"))
+	s.Equal("other-role", externalName("otherRole"))
+
+	// each resource is got by name, but roles and their tags are fetched once
+	calls := map[string]int{}
+	for _, c := range iamClient.Calls {
+		calls[c]++
+	}
+	s.Equal(map[string]int{"GetRole": 2, "ListRoles": 1, "ListRoleTags": 2}, calls)
+}
+
+func (s *functionSuite) TestRunFunction_InventoryBackend_ShouldServeExampleInventories() {
+	testCases := []struct {
+		inventory    string
+		wantSeverity fnv1.Severity
+		wantMessage  string
+		externalName string
+	}{
+		{
+			inventory:    "import.yaml",
+			wantSeverity: fnv1.Severity_SEVERITY_NORMAL,
+			externalName: "sg-0123456789abcdef0",
+		},
+		{
+			inventory:    "ambiguous.yaml",
+			wantSeverity: fnv1.Severity_SEVERITY_FATAL,
+			wantMessage:  "found more than one matching resource",
+		},
+		{
+			inventory:    "missing-tag.yaml",
+			wantSeverity: fnv1.Severity_SEVERITY_FATAL,
+			wantMessage:  `"crossplane-external-name" tag is not present or is empty`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.inventory, func() {
+			client, err := inventory.Load(filepath.Join("example", "inventory", tc.inventory))
+			s.Require().NoError(err)
+
+			fn := &Function{log: logging.NewNopLogger(), client: client}
+			rsp, err := fn.RunFunction(context.Background(), s.req())
+
+			s.NoError(err)
+			s.Equalf(tc.wantSeverity, rsp.Results[0].Severity, "msg: %s", rsp.Results[0].GetMessage())
+			s.Contains(rsp.Results[0].GetMessage(), tc.wantMessage)
+
+			got := rsp.GetDesired().GetResources()["securityGroup"].GetResource().
+				GetFields()["metadata"].GetStructValue().
+				GetFields()["annotations"].GetStructValue().
+				GetFields()["crossplane.io/external-name"].GetStringValue()
+			s.Equal(tc.externalName, got)
+		})
+	}
+}
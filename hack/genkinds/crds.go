@@ -0,0 +1,128 @@
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// readCRDs reads all CRDs in YAML files of a directory or tarball, recursively. Other documents are ignored.
+func readCRDs(path string) ([]extv1.CustomResourceDefinition, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return readCRDsFromDir(path)
+	}
+	return readCRDsFromTarball(path)
+}
+
+func readCRDsFromDir(dir string) ([]extv1.CustomResourceDefinition, error) {
+	var crds []extv1.CustomResourceDefinition
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !isYAML(path) {
+			return err
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		found, err := decodeCRDs(b)
+		if err != nil {
+			return errors.Wrapf(err, "decoding %s", path)
+		}
+		crds = append(crds, found...)
+		return nil
+	})
+	return crds, err
+}
+
+func readCRDsFromTarball(path string) ([]extv1.CustomResourceDefinition, error) {
+	f, err := os.Open(path) //nolint:gosec // reading user-provided files is the point
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck // read-only
+
+	var r io.Reader = bufio.NewReader(f)
+	if strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".tgz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompressing tarball")
+		}
+		defer gz.Close() //nolint:errcheck // read-only
+		r = gz
+	}
+
+	var crds []extv1.CustomResourceDefinition
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return crds, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "reading tarball")
+		}
+		if hdr.Typeflag != tar.TypeReg || !isYAML(hdr.Name) {
+			continue
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading %s", hdr.Name)
+		}
+		found, err := decodeCRDs(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding %s", hdr.Name)
+		}
+		crds = append(crds, found...)
+	}
+}
+
+// decodeCRDs decodes the CRDs among the documents of a YAML stream
+func decodeCRDs(b []byte) ([]extv1.CustomResourceDefinition, error) {
+	var crds []extv1.CustomResourceDefinition
+	dec := kyaml.NewYAMLOrJSONDecoder(bytes.NewReader(b), 4096)
+	for {
+		doc := json.RawMessage{}
+		err := dec.Decode(&doc)
+		if err == io.EOF {
+			return crds, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		tm := metav1.TypeMeta{}
+		if err := json.Unmarshal(doc, &tm); err != nil || tm.Kind != "CustomResourceDefinition" {
+			// not a CRD, maybe not even an object
+			continue
+		}
+
+		crd := extv1.CustomResourceDefinition{}
+		if err := json.Unmarshal(doc, &crd); err != nil {
+			return nil, err
+		}
+		crds = append(crds, crd)
+	}
+}
+
+func isYAML(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".yaml" || ext == ".yml"
+}
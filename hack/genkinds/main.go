@@ -0,0 +1,260 @@
+// Package main generates the registry of provider-upjet-aws kinds known to the function, internal/zz_kinds.go, from the
+// provider CRDs and the hand-maintained metadata in hack/kind_metadata.yaml. It works offline: CRDs are read from a local
+// directory or tarball, eg. the provider's release source tarball.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/alecthomas/kong"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// CLI of the generator.
+type CLI struct {
+	CRDs                string `name:"crds" help:"Directory or tarball (.tar, .tar.gz, .tgz) containing provider-upjet-aws CRDs. The registry is left untouched if unset, unless --strict is set."`
+	Strict              bool   `help:"Fail instead of leaving the registry untouched when no CRDs are given, so drift isn't missed in CI." env:"CI"`
+	ProviderVersionFile string `help:"File containing the pinned provider-upjet-aws version the CRDs belong to." type:"existingfile" required:""`
+	Metadata            string `help:"Hand-maintained metadata of kinds that can't be inferred from their CRDs." type:"existingfile" required:""`
+	Out                 string `help:"Go file to write the registry to." required:""`
+}
+
+// kindMetadata mirrors hack/kind_metadata.yaml entries
+type kindMetadata struct {
+	ResourceType        string         `json:"resourceType"`
+	CloudFormationType  string         `json:"cloudFormationType"`
+	AWSConfigType       string         `json:"awsConfigType"`
+	ExternalNameFromARN string         `json:"externalNameFromARN"`
+	TagEntryDefaults    map[string]any `json:"tagEntryDefaults"`
+}
+
+// kind is an entry of the generated registry
+type kind struct {
+	GroupKind           string
+	TagsField           string
+	TagsShape           string
+	Global              bool
+	ResourceType        string
+	CloudFormationType  string
+	AWSConfigType       string
+	ExternalNameFromARN string
+	// TagEntryDefaults is the Go literal of the fields set on entries added to list-shaped tags, empty if there are none
+	TagEntryDefaults string
+}
+
+var awsGroup = regexp.MustCompile(`^[a-z0-9]+\.aws(\.m)?\.upbound\.io$`)
+
+// Run the generator.
+func (c *CLI) Run() error {
+	if c.CRDs == "" && c.Strict {
+		return errors.Errorf("no CRDs given to generate %s from, run \"make fetch-provider-crds\" or set PROVIDER_UPJET_AWS_CRDS", c.Out)
+	}
+	if c.CRDs == "" {
+		fmt.Printf("No CRDs given, leaving %s untouched\n", c.Out)
+		return nil
+	}
+
+	version, err := os.ReadFile(c.ProviderVersionFile)
+	if err != nil {
+		return errors.Wrap(err, "reading provider version")
+	}
+
+	metadata, err := readMetadata(c.Metadata)
+	if err != nil {
+		return err
+	}
+
+	crds, err := readCRDs(c.CRDs)
+	if err != nil {
+		return errors.Wrapf(err, "reading CRDs from %s", c.CRDs)
+	}
+
+	src, err := render(strings.TrimSpace(string(version)), kinds(crds, metadata))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.Out, src, 0o644); err != nil { //nolint:gosec // generated source code is not sensitive
+		return errors.Wrapf(err, "writing %s", c.Out)
+	}
+
+	fmt.Printf("Generated %s from %d CRDs\n", c.Out, len(crds))
+	return nil
+}
+
+func readMetadata(path string) (map[string]kindMetadata, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading kind metadata")
+	}
+
+	metadata := map[string]kindMetadata{}
+	if err := yaml.UnmarshalStrict(b, &metadata); err != nil {
+		return nil, errors.Wrap(err, "parsing kind metadata")
+	}
+
+	for key, md := range metadata {
+		if md.ExternalNameFromARN == "" {
+			continue
+		}
+		re, err := regexp.Compile(md.ExternalNameFromARN)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compiling externalNameFromARN of %s", key)
+		}
+		if re.SubexpIndex("name") < 0 {
+			return nil, errors.Errorf("externalNameFromARN of %s has no \"name\" group", key)
+		}
+		if strings.Contains(md.ExternalNameFromARN, "`") {
+			return nil, errors.Errorf("externalNameFromARN of %s must not contain backticks", key)
+		}
+	}
+	for key, md := range metadata {
+		if _, err := goLiteral(md.TagEntryDefaults); err != nil {
+			return nil, errors.Wrapf(err, "tagEntryDefaults of %s", key)
+		}
+	}
+	return metadata, nil
+}
+
+// goLiteral renders fields as a map[string]any Go literal with sorted keys, or an empty string if there are no fields.
+// Only scalar values are supported.
+func goLiteral(fields map[string]any) (string, error) {
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	entries := make([]string, 0, len(keys))
+	for _, k := range keys {
+		var v string
+		switch t := fields[k].(type) {
+		case string:
+			v = strconv.Quote(t)
+		case bool:
+			v = strconv.FormatBool(t)
+		case float64:
+			v = strconv.FormatFloat(t, 'f', -1, 64)
+		default:
+			return "", errors.Errorf("field %q must be a string, a boolean or a number, got %T", k, t)
+		}
+		entries = append(entries, strconv.Quote(k)+": "+v)
+	}
+	return "map[string]any{" + strings.Join(entries, ", ") + "}", nil
+}
+
+// kinds builds the sorted registry entries of AWS CRDs, deduplicated by group-kind
+func kinds(crds []extv1.CustomResourceDefinition, metadata map[string]kindMetadata) []kind {
+	byGroupKind := map[string]kind{}
+	for _, crd := range crds {
+		if !awsGroup.MatchString(crd.Spec.Group) {
+			continue
+		}
+
+		singular := strings.ToLower(crd.Spec.Names.Singular)
+		if singular == "" {
+			singular = strings.ToLower(crd.Spec.Names.Kind)
+		}
+		service, _, _ := strings.Cut(crd.Spec.Group, ".")
+		md := metadata[singular+"."+service]
+
+		// validated by readMetadata
+		tagEntryDefaults, _ := goLiteral(md.TagEntryDefaults)
+		k := kind{
+			GroupKind:           singular + "." + crd.Spec.Group,
+			ResourceType:        md.ResourceType,
+			CloudFormationType:  md.CloudFormationType,
+			AWSConfigType:       md.AWSConfigType,
+			ExternalNameFromARN: md.ExternalNameFromARN,
+			TagEntryDefaults:    tagEntryDefaults,
+		}
+
+		forProvider := forProviderProperties(crd)
+		switch {
+		case forProvider["tags"].Type == "object":
+			k.TagsField, k.TagsShape = "tags", "tagShapeMap"
+		case forProvider["tag"].Type == "array":
+			k.TagsField, k.TagsShape = "tag", "tagShapeList"
+		}
+		_, regional := forProvider["region"]
+		k.Global = !regional
+
+		byGroupKind[k.GroupKind] = k
+	}
+
+	result := make([]kind, 0, len(byGroupKind))
+	for _, k := range byGroupKind {
+		result = append(result, k)
+	}
+	slices.SortFunc(result, func(a, b kind) int { return strings.Compare(a.GroupKind, b.GroupKind) })
+	return result
+}
+
+// forProviderProperties returns the properties of .spec.forProvider in the CRD's storage version
+func forProviderProperties(crd extv1.CustomResourceDefinition) map[string]extv1.JSONSchemaProps {
+	for _, v := range crd.Spec.Versions {
+		if !v.Storage || v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		return v.Schema.OpenAPIV3Schema.Properties["spec"].Properties["forProvider"].Properties
+	}
+	return nil
+}
+
+var registryTemplate = template.Must(template.New("registry").Parse(`// this file has been autogenerated by "go generate". Do not edit it manually
+// provider-upjet-aws version: {{ .Version }}
+//
+//go:generate go run ../hack/genkinds --crds=${PROVIDER_UPJET_AWS_CRDS} --provider-version-file=../hack/provider-upjet-aws.version --metadata=../hack/kind_metadata.yaml --out=zz_kinds.go
+package internal
+
+import "regexp"
+
+var kindRegistry = map[string]kindMetadata{
+{{- range .Kinds }}
+	"{{ .GroupKind }}": {
+		{{- if .TagsField }}tagsField: "{{ .TagsField }}", tagsShape: {{ .TagsShape }}, {{ end }}
+		{{- if .TagEntryDefaults }}tagEntryDefaults: {{ .TagEntryDefaults }}, {{ end }}
+		{{- if .Global }}global: true, {{ end }}
+		{{- if .ResourceType }}resourceType: "{{ .ResourceType }}", {{ end }}
+		{{- if .CloudFormationType }}cloudFormationType: "{{ .CloudFormationType }}", {{ end }}
+		{{- if .AWSConfigType }}awsConfigType: "{{ .AWSConfigType }}", {{ end }}
+		{{- if .ExternalNameFromARN }}externalNameFromARN: regexp.MustCompile(` + "`{{ .ExternalNameFromARN }}`" + `), {{ end -}}
+	},
+{{- end }}
+}
+`))
+
+func render(version string, kinds []kind) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	err := registryTemplate.Execute(buf, struct {
+		Version string
+		Kinds   []kind
+	}{Version: version, Kinds: kinds})
+	if err != nil {
+		return nil, errors.Wrap(err, "rendering registry")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "formatting registry")
+	}
+	return src, nil
+}
+
+func main() {
+	ctx := kong.Parse(&CLI{}, kong.Description("Generates the registry of provider-upjet-aws kinds known to the function."))
+	ctx.FatalIfErrorf(ctx.Run())
+}
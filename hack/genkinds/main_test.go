@@ -0,0 +1,145 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+func TestGeneratorSuite(t *testing.T) {
+	suite.Run(t, &generatorSuite{})
+}
+
+type generatorSuite struct {
+	suite.Suite
+}
+
+var wantKinds = []kind{
+	{
+		GroupKind:        "autoscalinggroup.autoscaling.aws.m.upbound.io",
+		TagsField:        "tag",
+		TagsShape:        "tagShapeList",
+		TagEntryDefaults: `map[string]any{"propagateAtLaunch": false}`,
+	},
+	{GroupKind: "role.iam.aws.upbound.io", TagsField: "tags", TagsShape: "tagShapeMap", Global: true, ResourceType: "iam:role"},
+	{GroupKind: "route.ec2.aws.upbound.io"},
+	{
+		GroupKind:           "securitygroup.ec2.aws.upbound.io",
+		TagsField:           "tags",
+		TagsShape:           "tagShapeMap",
+		ResourceType:        "ec2:security-group",
+		CloudFormationType:  "AWS::EC2::SecurityGroup",
+		AWSConfigType:       "AWS::EC2::SecurityGroup",
+		ExternalNameFromARN: ":security-group/(?P<name>[^/]+)$",
+	},
+}
+
+func (s *generatorSuite) TestKinds_FromDir_ShouldBeDeduplicatedAndSorted() {
+	crds, err := readCRDs("testdata/crds")
+	s.Require().NoError(err)
+	s.Len(crds, 6)
+
+	metadata, err := readMetadata("testdata/metadata.yaml")
+	s.Require().NoError(err)
+
+	s.Equal(wantKinds, kinds(crds, metadata))
+}
+
+func (s *generatorSuite) TestKinds_FromTarball_ShouldMatchDir() {
+	tarball := filepath.Join(s.T().TempDir(), "crds.tar.gz")
+	s.Require().NoError(writeTarball(tarball, "testdata/crds"))
+
+	crds, err := readCRDs(tarball)
+	s.Require().NoError(err)
+
+	metadata, err := readMetadata("testdata/metadata.yaml")
+	s.Require().NoError(err)
+
+	s.Equal(wantKinds, kinds(crds, metadata))
+}
+
+func (s *generatorSuite) TestReadMetadata_PatternWithoutNameGroup_ShouldFail() {
+	path := filepath.Join(s.T().TempDir(), "metadata.yaml")
+	s.Require().NoError(os.WriteFile(path, []byte("vpc.ec2:\n  externalNameFromARN: ':vpc/(.+)$'\n"), 0o600))
+
+	_, err := readMetadata(path)
+	s.ErrorContains(err, `no "name" group`)
+}
+
+func (s *generatorSuite) TestReadMetadata_NonScalarTagEntryDefault_ShouldFail() {
+	path := filepath.Join(s.T().TempDir(), "metadata.yaml")
+	s.Require().NoError(os.WriteFile(path, []byte("autoscalinggroup.autoscaling:\n  tagEntryDefaults:\n    foo: [bar]\n"), 0o600))
+
+	_, err := readMetadata(path)
+	s.ErrorContains(err, `field "foo" must be a string, a boolean or a number`)
+}
+
+func (s *generatorSuite) TestRun_ShouldWriteFormattedRegistry() {
+	dir := s.T().TempDir()
+	versionFile := filepath.Join(dir, "version")
+	s.Require().NoError(os.WriteFile(versionFile, []byte("v1.2.3\n"), 0o600))
+
+	cli := &CLI{
+		CRDs:                "testdata/crds",
+		ProviderVersionFile: versionFile,
+		Metadata:            "testdata/metadata.yaml",
+		Out:                 filepath.Join(dir, "zz_kinds.go"),
+	}
+	s.Require().NoError(cli.Run())
+
+	got, err := os.ReadFile(cli.Out)
+	s.Require().NoError(err)
+	s.Contains(string(got), "// provider-upjet-aws version: v1.2.3\n")
+	s.Contains(string(got), `"autoscalinggroup.autoscaling.aws.m.upbound.io": {tagsField: "tag", tagsShape: tagShapeList, tagEntryDefaults: map[string]any{"propagateAtLaunch": false}},`)
+	s.Contains(string(got), `"route.ec2.aws.upbound.io":                      {},`)
+	s.Contains(string(got), `"securitygroup.ec2.aws.upbound.io":              {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:security-group", cloudFormationType: "AWS::EC2::SecurityGroup", awsConfigType: "AWS::EC2::SecurityGroup", externalNameFromARN: regexp.MustCompile(`+"`:security-group/(?P<name>[^/]+)$`"+`)},`)
+}
+
+func (s *generatorSuite) TestRun_NoCRDs_ShouldLeaveRegistryUntouched() {
+	cli := &CLI{Out: filepath.Join(s.T().TempDir(), "zz_kinds.go")}
+	s.Require().NoError(cli.Run())
+	s.NoFileExists(cli.Out)
+}
+
+func (s *generatorSuite) TestRun_NoCRDsInStrictMode_ShouldFail() {
+	cli := &CLI{Out: filepath.Join(s.T().TempDir(), "zz_kinds.go"), Strict: true}
+	s.ErrorContains(cli.Run(), "no CRDs given")
+	s.NoFileExists(cli.Out)
+}
+
+func writeTarball(path, dir string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck // closed by the gzip writer
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: p, Mode: 0o600, Size: int64(len(b)), Typeflag: tar.TypeReg}); err != nil {
+			return err
+		}
+		_, err = tw.Write(b)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
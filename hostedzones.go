@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/crossplane/function-sdk-go/logging"
+
+	"github.com/gympass/function-aws-importer/internal"
+)
+
+// hostedZonesAPIClient lists Route53 hosted zones by DNS name, or by associated VPC for private ones, and their tags
+type hostedZonesAPIClient interface {
+	ListHostedZonesByName(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error)
+	ListHostedZonesByVPC(ctx context.Context, params *route53.ListHostedZonesByVPCInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByVPCOutput, error)
+	ListTagsForResources(ctx context.Context, params *route53.ListTagsForResourcesInput, optFns ...func(*route53.Options)) (*route53.ListTagsForResourcesOutput, error)
+}
+
+// maxHostedZonesPerTagsCall is how many hosted zones Route53 lists the tags of at most in a single call
+const maxHostedZonesPerTagsCall = 10
+
+// hostedZoneVPCFields are the fields holding the first VPC a private hosted zone is associated with, in each kind of
+// hosted zone that can be looked up by natural key. Upjet's zones take a list of VPCs, the classic ones a single VPC.
+var hostedZoneVPCFields = map[string]string{
+	"zone.route53.aws.upbound.io":          "spec.forProvider.vpc[0]",
+	"zone.route53.aws.m.upbound.io":        "spec.forProvider.vpc[0]",
+	"hostedzone.route53.aws.crossplane.io": "spec.forProvider.vpc",
+}
+
+// hostedZoneFinder looks Route53 hosted zones up by their DNS name and, for private zones, their first associated VPC,
+// so hosted zones created before the function existed or stripped of their tags can still be imported. Unlike security
+// groups, several hosted zones can share the same name, in which case the function refuses to pick any of them.
+type hostedZoneFinder struct {
+	log    logging.Logger
+	client hostedZonesAPIClient
+}
+
+// UsesTags returns false, as hosted zones are looked up by their natural key
+func (f hostedZoneFinder) UsesTags() bool { return false }
+
+// Find returns no candidates if the resource isn't a hosted zone, or if it has no name set. Hosted zones whose Crossplane
+// tags name another resource are skipped.
+func (f hostedZoneFinder) Find(ctx context.Context, req FindRequest) ([]Candidate, error) {
+	desiredComposed := req.Resource
+	vpcField, ok := hostedZoneVPCFields[desiredComposed.GroupKind()]
+	if !ok {
+		return nil, nil
+	}
+
+	name, hasName, err := desiredComposed.DesiredString("spec.forProvider.name")
+	if err != nil {
+		return nil, err
+	}
+	if !hasName {
+		f.log.Debug("Cannot look hosted zone up by natural key, name is not set",
+			"resource", desiredComposed.CompositionName(),
+		)
+		return nil, nil
+	}
+
+	// a hosted zone associated with a VPC is private, even if the VPC's ID isn't resolved from its reference yet
+	private, err := desiredComposed.HasDesiredField(vpcField)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	if private {
+		vpcID, hasVPCID, err := desiredComposed.DesiredString(vpcField + ".vpcId")
+		if err != nil {
+			return nil, err
+		}
+		if !hasVPCID {
+			f.log.Debug("Cannot look private hosted zone up by natural key, VPC ID is not resolved yet",
+				"resource", desiredComposed.CompositionName(),
+			)
+			return nil, nil
+		}
+		vpcRegion, err := hostedZoneVPCRegion(desiredComposed, vpcField)
+		if err != nil {
+			return nil, err
+		}
+		if len(vpcRegion) == 0 {
+			f.log.Debug("Cannot look private hosted zone up by natural key, VPC region is not set",
+				"resource", desiredComposed.CompositionName(),
+			)
+			return nil, nil
+		}
+		ids, err = f.listPrivateHostedZones(ctx, name, vpcID, vpcRegion)
+		if err != nil {
+			return nil, fmt.Errorf("listing hosted zones associated with VPC %q: %w", vpcID, err)
+		}
+	} else {
+		ids, err = f.listPublicHostedZones(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("listing hosted zones named %q: %w", name, err)
+		}
+	}
+
+	tags, err := f.hostedZoneTags(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("listing tags of hosted zones %v: %w", ids, err)
+	}
+
+	var candidates []Candidate
+	for _, id := range ids {
+		if namesOtherResource(tags[id], req) {
+			f.log.Debug("Skipped hosted zone tagged as another resource",
+				"resource", desiredComposed.CompositionName(),
+				"hostedZone", id,
+			)
+			continue
+		}
+		candidates = append(candidates, Candidate{Tags: tags[id], ExternalName: id})
+	}
+	return candidates, nil
+}
+
+// hostedZoneTags returns the tags of the hosted zones with the given IDs, converted to Resource Groups Tagging API
+// ones so tag filters can be applied to them
+func (f hostedZoneFinder) hostedZoneTags(ctx context.Context, ids []string) (map[string][]types.Tag, error) {
+	tags := make(map[string][]types.Tag, len(ids))
+	for batch := range slices.Chunk(ids, maxHostedZonesPerTagsCall) {
+		out, err := f.client.ListTagsForResources(ctx, &route53.ListTagsForResourcesInput{
+			ResourceType: route53types.TagResourceTypeHostedzone,
+			ResourceIds:  batch,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, set := range out.ResourceTagSets {
+			id := hostedZoneID(aws.ToString(set.ResourceId))
+			for _, t := range set.Tags {
+				tags[id] = append(tags[id], types.Tag{Key: t.Key, Value: t.Value})
+			}
+		}
+	}
+	return tags, nil
+}
+
+// listPublicHostedZones lists the IDs of public hosted zones named name. Hosted zones are listed in DNS name order,
+// starting from name, so listing stops at the first zone with another name.
+func (f hostedZoneFinder) listPublicHostedZones(ctx context.Context, name string) ([]string, error) {
+	input := &route53.ListHostedZonesByNameInput{DNSName: aws.String(name)}
+
+	var ids []string
+	for {
+		page, err := f.client.ListHostedZonesByName(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, z := range page.HostedZones {
+			if !sameDNSName(aws.ToString(z.Name), name) {
+				return ids, nil
+			}
+			if z.Config != nil && z.Config.PrivateZone {
+				continue
+			}
+			ids = append(ids, hostedZoneID(aws.ToString(z.Id)))
+		}
+
+		if !page.IsTruncated {
+			return ids, nil
+		}
+		input = &route53.ListHostedZonesByNameInput{DNSName: page.NextDNSName, HostedZoneId: page.NextHostedZoneId}
+	}
+}
+
+// listPrivateHostedZones lists the IDs of hosted zones named name that are associated with the given VPC
+func (f hostedZoneFinder) listPrivateHostedZones(ctx context.Context, name, vpcID, vpcRegion string) ([]string, error) {
+	input := &route53.ListHostedZonesByVPCInput{VPCId: aws.String(vpcID), VPCRegion: route53types.VPCRegion(vpcRegion)}
+
+	var ids []string
+	for {
+		page, err := f.client.ListHostedZonesByVPC(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, z := range page.HostedZoneSummaries {
+			if sameDNSName(aws.ToString(z.Name), name) {
+				ids = append(ids, hostedZoneID(aws.ToString(z.HostedZoneId)))
+			}
+		}
+
+		if len(aws.ToString(page.NextToken)) == 0 {
+			return ids, nil
+		}
+		input.NextToken = page.NextToken
+	}
+}
+
+// hostedZoneVPCRegion returns the region of the first VPC associated with the hosted zone, which defaults to the
+// resource's region. Classic hosted zones have no region, so it's empty unless the VPC's is set.
+func hostedZoneVPCRegion(desiredComposed internal.Resource, vpcField string) (string, error) {
+	vpcRegion, ok, err := desiredComposed.DesiredString(vpcField + ".vpcRegion")
+	if err != nil || ok {
+		return vpcRegion, err
+	}
+	region, _, err := desiredComposed.DesiredString("spec.forProvider.region")
+	return region, err
+}
+
+// sameDNSName compares DNS names case-insensitively, regardless of trailing dots, as Route53 returns fully qualified
+// names
+func sameDNSName(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// hostedZoneID strips the "/hostedzone/" prefix some Route53 operations return hosted zone IDs with
+func hostedZoneID(id string) string {
+	return strings.TrimPrefix(id, "/hostedzone/")
+}
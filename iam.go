@@ -0,0 +1,351 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// iamAPIClient gets and lists IAM entities and their tags
+type iamAPIClient interface {
+	iam.GetRoleAPIClient
+	iam.GetUserAPIClient
+	iam.GetInstanceProfileAPIClient
+	iam.ListRolesAPIClient
+	iam.ListRoleTagsAPIClient
+	iam.ListPoliciesAPIClient
+	iam.ListPolicyTagsAPIClient
+	iam.ListUsersAPIClient
+	iam.ListUserTagsAPIClient
+	iam.ListInstanceProfilesAPIClient
+	iam.ListInstanceProfileTagsAPIClient
+}
+
+// iamEntity is an IAM entity listed by the IAM finder
+type iamEntity struct {
+	arn string
+	// externalName of the entity's kind, its name, or its ARN for policies
+	externalName string
+	// tags lists the entity's tags
+	tags func(ctx context.Context, client iamAPIClient) ([]iamtypes.Tag, error)
+}
+
+// iamEntityKind is how the IAM finder looks entities of a kind up
+type iamEntityKind struct {
+	// get returns the entity with the given name, or none if there's no such entity. It's nil for kinds that can't be
+	// got by name, like policies, which are got by an ARN including the account ID.
+	get func(ctx context.Context, client iamAPIClient, name string) ([]iamEntity, error)
+	// list returns all entities of the kind
+	list func(ctx context.Context, client iamAPIClient) ([]iamEntity, error)
+}
+
+// iamEntityKinds are the IAM entity kinds the IAM finder looks up, by their kind and service
+var iamEntityKinds = map[string]iamEntityKind{
+	"role.iam":            {get: getIAMRole, list: listIAMRoles},
+	"policy.iam":          {list: listIAMPolicies},
+	"user.iam":            {get: getIAMUser, list: listIAMUsers},
+	"instanceprofile.iam": {get: getIAMInstanceProfile, list: listIAMInstanceProfiles},
+}
+
+// isIAMGroupKind returns true if the given lower-case group-kind is a provider-upjet-aws IAM kind
+func isIAMGroupKind(groupKind string) bool {
+	_, group, _ := strings.Cut(groupKind, ".")
+	return group == "iam.aws.upbound.io" || group == "iam.aws.m.upbound.io"
+}
+
+// iamFinder looks IAM entities up by their name first, as it's usually the resource's own name, then by listing all
+// entities of their kind. Either way, their Crossplane tags must match. IAM is global, so unlike the Tagging API, it
+// finds them regardless of the function's region.
+type iamFinder struct {
+	client iamAPIClient
+	// entities holds the entities listed, and their tags, while running the function, so they're fetched once for all
+	// resources
+	entities *iamEntityCache
+	// named holds the entity named like the resource, so trying each of its kind aliases doesn't get it again. It's
+	// shared by copies of the finder, which must not be reused for other resources.
+	named *iamNamedEntity
+}
+
+// iamEntityCache holds the IAM entities listed by IAM finders, by kind, and their tags, by ARN
+type iamEntityCache struct {
+	listed map[string][]iamEntity
+	tags   map[string][]types.Tag
+}
+
+// newIAMEntityCache returns an empty cache, to be shared by the IAM finders of a single function run
+func newIAMEntityCache() *iamEntityCache {
+	return &iamEntityCache{listed: map[string][]iamEntity{}, tags: map[string][]types.Tag{}}
+}
+
+// iamNamedEntity holds the IAM entity an iamFinder got by the name of the resource it looks up, if any
+type iamNamedEntity struct {
+	entities []iamEntity
+	got      bool
+}
+
+// newIAMFinder returns an IAM finder to look a single resource up, sharing the entities listed with other resources'
+func newIAMFinder(client iamAPIClient, entities *iamEntityCache) iamFinder {
+	return iamFinder{client: client, entities: entities, named: &iamNamedEntity{}}
+}
+
+func (i iamFinder) UsesTags() bool { return true }
+
+// Find returns no candidates if the resource isn't an IAM role, policy, user or instance profile
+func (i iamFinder) Find(ctx context.Context, req FindRequest) ([]Candidate, error) {
+	if !isIAMGroupKind(req.Resource.GroupKind()) {
+		return nil, nil
+	}
+	kind, _, _ := strings.Cut(req.Resource.GroupKind(), ".")
+	kind += ".iam"
+	entityKind, ok := iamEntityKinds[kind]
+	if !ok {
+		return nil, nil
+	}
+	tagFilters := tagFiltersFor(req.Filters, req.Resource, req.Kind)
+
+	if entityKind.get != nil {
+		named, err := i.namedEntity(ctx, req, entityKind)
+		if err != nil {
+			return nil, fmt.Errorf("getting IAM entity: %w", err)
+		}
+		candidates, err := i.matching(ctx, named, tagFilters)
+		if err != nil || len(candidates) > 0 {
+			return candidates, err
+		}
+	}
+
+	listed, ok := i.entities.listed[kind]
+	if !ok {
+		var err error
+		listed, err = entityKind.list(ctx, i.client)
+		if err != nil {
+			return nil, fmt.Errorf("listing IAM entities: %w", err)
+		}
+		i.entities.listed[kind] = listed
+	}
+	return i.matching(ctx, listed, tagFilters)
+}
+
+// namedEntity gets the entity named like the resource: its desired spec.forProvider.name if set, or its Kubernetes name,
+// which Crossplane uses as external name by default
+func (i iamFinder) namedEntity(ctx context.Context, req FindRequest, entityKind iamEntityKind) ([]iamEntity, error) {
+	if i.named.got {
+		return i.named.entities, nil
+	}
+
+	name, ok, err := req.Resource.DesiredString("spec.forProvider.name")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		name = req.Resource.K8sName()
+	}
+
+	named, err := entityKind.get(ctx, i.client, name)
+	if err != nil {
+		return nil, err
+	}
+	i.named.entities, i.named.got = named, true
+	return named, nil
+}
+
+// matching returns the entities whose tags match tagFilters as candidates
+func (i iamFinder) matching(ctx context.Context, entities []iamEntity, tagFilters []types.TagFilter) ([]Candidate, error) {
+	var candidates []Candidate
+	for _, e := range entities {
+		tags, ok := i.entities.tags[e.arn]
+		if !ok {
+			iamTags, err := e.tags(ctx, i.client)
+			if err != nil {
+				return nil, fmt.Errorf("listing tags of %s: %w", e.arn, err)
+			}
+			tags = make([]types.Tag, 0, len(iamTags))
+			for _, t := range iamTags {
+				tags = append(tags, types.Tag{Key: t.Key, Value: t.Value})
+			}
+			i.entities.tags[e.arn] = tags
+		}
+
+		if !matchesAllTagFilters(tags, tagFilters) {
+			continue
+		}
+		candidates = append(candidates, Candidate{
+			ARN:          e.arn,
+			Tags:         tags,
+			ExternalName: e.externalName,
+		})
+	}
+	return candidates, nil
+}
+
+// gotTags returns the tags of an entity that were returned along with it
+func gotTags(tags []iamtypes.Tag) func(context.Context, iamAPIClient) ([]iamtypes.Tag, error) {
+	return func(context.Context, iamAPIClient) ([]iamtypes.Tag, error) {
+		return tags, nil
+	}
+}
+
+// ignoreNoSuchEntity returns nil if err means the IAM entity doesn't exist
+func ignoreNoSuchEntity(err error) error {
+	var notFound *iamtypes.NoSuchEntityException
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	return err
+}
+
+func getIAMRole(ctx context.Context, client iamAPIClient, name string) ([]iamEntity, error) {
+	out, err := client.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(name)})
+	if err != nil {
+		return nil, ignoreNoSuchEntity(err)
+	}
+	return []iamEntity{{arn: aws.ToString(out.Role.Arn), externalName: aws.ToString(out.Role.RoleName), tags: gotTags(out.Role.Tags)}}, nil
+}
+
+func getIAMUser(ctx context.Context, client iamAPIClient, name string) ([]iamEntity, error) {
+	out, err := client.GetUser(ctx, &iam.GetUserInput{UserName: aws.String(name)})
+	if err != nil {
+		return nil, ignoreNoSuchEntity(err)
+	}
+	return []iamEntity{{arn: aws.ToString(out.User.Arn), externalName: aws.ToString(out.User.UserName), tags: gotTags(out.User.Tags)}}, nil
+}
+
+func getIAMInstanceProfile(ctx context.Context, client iamAPIClient, name string) ([]iamEntity, error) {
+	out, err := client.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(name)})
+	if err != nil {
+		return nil, ignoreNoSuchEntity(err)
+	}
+	p := out.InstanceProfile
+	return []iamEntity{{arn: aws.ToString(p.Arn), externalName: aws.ToString(p.InstanceProfileName), tags: gotTags(p.Tags)}}, nil
+}
+
+func listIAMRoles(ctx context.Context, client iamAPIClient) ([]iamEntity, error) {
+	var entities []iamEntity
+	paginator := iam.NewListRolesPaginator(client, &iam.ListRolesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing roles: %w", err)
+		}
+		for _, r := range page.Roles {
+			name := aws.ToString(r.RoleName)
+			entities = append(entities, iamEntity{
+				arn:          aws.ToString(r.Arn),
+				externalName: name,
+				tags: func(ctx context.Context, client iamAPIClient) ([]iamtypes.Tag, error) {
+					var tags []iamtypes.Tag
+					paginator := iam.NewListRoleTagsPaginator(client, &iam.ListRoleTagsInput{RoleName: aws.String(name)})
+					for paginator.HasMorePages() {
+						page, err := paginator.NextPage(ctx)
+						if err != nil {
+							return nil, err
+						}
+						tags = append(tags, page.Tags...)
+					}
+					return tags, nil
+				},
+			})
+		}
+	}
+	return entities, nil
+}
+
+// listIAMPolicies lists customer managed policies only, as AWS managed ones can't be tagged
+func listIAMPolicies(ctx context.Context, client iamAPIClient) ([]iamEntity, error) {
+	var entities []iamEntity
+	paginator := iam.NewListPoliciesPaginator(client, &iam.ListPoliciesInput{Scope: iamtypes.PolicyScopeTypeLocal})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing policies: %w", err)
+		}
+		for _, p := range page.Policies {
+			arn := aws.ToString(p.Arn)
+			entities = append(entities, iamEntity{
+				arn:          arn,
+				externalName: arn,
+				tags: func(ctx context.Context, client iamAPIClient) ([]iamtypes.Tag, error) {
+					var tags []iamtypes.Tag
+					paginator := iam.NewListPolicyTagsPaginator(client, &iam.ListPolicyTagsInput{PolicyArn: aws.String(arn)})
+					for paginator.HasMorePages() {
+						page, err := paginator.NextPage(ctx)
+						if err != nil {
+							return nil, err
+						}
+						tags = append(tags, page.Tags...)
+					}
+					return tags, nil
+				},
+			})
+		}
+	}
+	return entities, nil
+}
+
+func listIAMUsers(ctx context.Context, client iamAPIClient) ([]iamEntity, error) {
+	var entities []iamEntity
+	paginator := iam.NewListUsersPaginator(client, &iam.ListUsersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing users: %w", err)
+		}
+		for _, u := range page.Users {
+			name := aws.ToString(u.UserName)
+			entities = append(entities, iamEntity{
+				arn:          aws.ToString(u.Arn),
+				externalName: name,
+				tags: func(ctx context.Context, client iamAPIClient) ([]iamtypes.Tag, error) {
+					var tags []iamtypes.Tag
+					paginator := iam.NewListUserTagsPaginator(client, &iam.ListUserTagsInput{UserName: aws.String(name)})
+					for paginator.HasMorePages() {
+						page, err := paginator.NextPage(ctx)
+						if err != nil {
+							return nil, err
+						}
+						tags = append(tags, page.Tags...)
+					}
+					return tags, nil
+				},
+			})
+		}
+	}
+	return entities, nil
+}
+
+func listIAMInstanceProfiles(ctx context.Context, client iamAPIClient) ([]iamEntity, error) {
+	var entities []iamEntity
+	paginator := iam.NewListInstanceProfilesPaginator(client, &iam.ListInstanceProfilesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing instance profiles: %w", err)
+		}
+		for _, p := range page.InstanceProfiles {
+			name := aws.ToString(p.InstanceProfileName)
+			entities = append(entities, iamEntity{
+				arn:          aws.ToString(p.Arn),
+				externalName: name,
+				tags: func(ctx context.Context, client iamAPIClient) ([]iamtypes.Tag, error) {
+					var tags []iamtypes.Tag
+					paginator := iam.NewListInstanceProfileTagsPaginator(client, &iam.ListInstanceProfileTagsInput{InstanceProfileName: aws.String(name)})
+					for paginator.HasMorePages() {
+						page, err := paginator.NextPage(ctx)
+						if err != nil {
+							return nil, err
+						}
+						tags = append(tags, page.Tags...)
+					}
+					return tags, nil
+				},
+			})
+		}
+	}
+	return entities, nil
+}
@@ -0,0 +1,72 @@
+package v1beta1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// KindAlias lists legacy "crossplane-kind" tag values that existing AWS resources may still carry, like after migrating
+// managed resources between provider API groups. Exactly one of GroupKind and Group must be set.
+type KindAlias struct {
+	// GroupKind of the managed resource, as "<kind>.<group>" (eg, "securitygroup.ec2.aws.m.upbound.io"). Its aliases
+	// are group-kinds. Case-insensitive.
+	// +optional
+	GroupKind string `json:"groupKind,omitempty"`
+
+	// Group of managed resources (eg, "ec2.aws.m.upbound.io"). Its aliases are groups, and apply to all of its kinds,
+	// keeping the kind itself. Case-insensitive.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Aliases are tried in order, after the actual group-kind finds nothing.
+	Aliases []string `json:"aliases"`
+}
+
+// KindAliasesFor returns the lower-case group-kinds to try, in order, when looking up resources of the given
+// lower-case group-kind finds nothing. Aliases of group-kinds come before aliases of groups.
+func (in *Input) KindAliasesFor(groupKind string) []string {
+	kind, group, _ := strings.Cut(groupKind, ".")
+
+	var result []string
+	add := func(alias string) {
+		alias = strings.ToLower(alias)
+		if alias != groupKind && !slices.Contains(result, alias) {
+			result = append(result, alias)
+		}
+	}
+
+	for _, a := range in.KindAliases {
+		if strings.EqualFold(a.GroupKind, groupKind) {
+			for _, alias := range a.Aliases {
+				add(alias)
+			}
+		}
+	}
+	for _, a := range in.KindAliases {
+		if len(a.Group) > 0 && strings.EqualFold(a.Group, group) {
+			for _, alias := range a.Aliases {
+				add(kind + "." + alias)
+			}
+		}
+	}
+	return result
+}
+
+func (a KindAlias) validate() error {
+	if (len(a.GroupKind) == 0) == (len(a.Group) == 0) {
+		return fmt.Errorf(`exactly one of "groupKind" and "group" must be set`)
+	}
+	if len(a.Aliases) == 0 {
+		return fmt.Errorf("%s%s has no aliases", a.GroupKind, a.Group)
+	}
+	for _, alias := range a.Aliases {
+		if len(alias) == 0 {
+			return fmt.Errorf("%s%s has an empty alias", a.GroupKind, a.Group)
+		}
+		if len(a.GroupKind) > 0 && !strings.Contains(alias, ".") {
+			return fmt.Errorf(`invalid alias %q of %s, expected "<kind>.<group>"`, alias, a.GroupKind)
+		}
+	}
+	return nil
+}
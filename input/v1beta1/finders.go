@@ -0,0 +1,95 @@
+package v1beta1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Finder is a backend the function looks up external resources with
+type Finder string
+
+const (
+	// FinderTaggingAPI looks resources up by their Crossplane tags with the Resource Groups Tagging API
+	FinderTaggingAPI Finder = "TaggingAPI"
+	// FinderSecurityGroupNaturalKey looks EC2 security groups up by their VPC ID and name
+	FinderSecurityGroupNaturalKey Finder = "SecurityGroupNaturalKey"
+	// FinderHostedZoneNaturalKey looks Route53 hosted zones up by their name and, for private ones, their VPC
+	FinderHostedZoneNaturalKey Finder = "HostedZoneNaturalKey"
+	// FinderCloudControl looks resources up by listing all resources of their CloudFormation type with the Cloud
+	// Control API and matching their Crossplane tags. It's not a default finder, as it's slower than the Tagging API.
+	FinderCloudControl Finder = "CloudControl"
+	// FinderAWSConfig looks resources up by their Crossplane tags with an advanced query on the AWS Config aggregator
+	// given to the function, across all the accounts and regions it aggregates. It's not a default finder, as it's
+	// only enabled when the function is given an aggregator.
+	FinderAWSConfig Finder = "AWSConfig"
+	// FinderIAM looks IAM roles, policies, users and instance profiles up by listing them and matching their Crossplane
+	// tags with the IAM API, regardless of the function's region
+	FinderIAM Finder = "IAM"
+)
+
+// DefaultFinders returns the finders tried in order when no FinderChain applies to the given lower-case group-kind.
+// IAM kinds are looked up with the IAM API first, as IAM is global and the Tagging API regional. Finders skip kinds they
+// don't support.
+func DefaultFinders(groupKind string) []Finder {
+	defaults := []Finder{FinderTaggingAPI, FinderSecurityGroupNaturalKey, FinderHostedZoneNaturalKey}
+
+	_, group, _ := strings.Cut(groupKind, ".")
+	if group == "iam.aws.upbound.io" || group == "iam.aws.m.upbound.io" {
+		return append([]Finder{FinderIAM}, defaults...)
+	}
+	return defaults
+}
+
+var validFinders = []Finder{FinderTaggingAPI, FinderSecurityGroupNaturalKey, FinderHostedZoneNaturalKey, FinderCloudControl, FinderAWSConfig, FinderIAM}
+
+// FinderChain sets the finders the external resources of a kind, or of all kinds of a group, are looked up with.
+// Exactly one of GroupKind and Group must be set.
+type FinderChain struct {
+	// GroupKind of the managed resource, as "<kind>.<group>" (eg, "securitygroup.ec2.aws.upbound.io").
+	// Case-insensitive.
+	// +optional
+	GroupKind string `json:"groupKind,omitempty"`
+
+	// Group of managed resources (eg, "ec2.aws.upbound.io"). Case-insensitive.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Finders are tried in order, until one of them finds the external resource.
+	// +kubebuilder:validation:items:Enum=TaggingAPI;SecurityGroupNaturalKey;HostedZoneNaturalKey;CloudControl;AWSConfig;IAM
+	Finders []Finder `json:"finders"`
+}
+
+// FindersFor returns the finders to look up external resources of the given lower-case group-kind with, in order, or
+// nil if no chain applies to it, in which case DefaultFinders are used. Chains of group-kinds take precedence over
+// chains of groups.
+func (in *Input) FindersFor(groupKind string) []Finder {
+	_, group, _ := strings.Cut(groupKind, ".")
+
+	for _, c := range in.FinderChains {
+		if strings.EqualFold(c.GroupKind, groupKind) {
+			return c.Finders
+		}
+	}
+	for _, c := range in.FinderChains {
+		if len(c.Group) > 0 && strings.EqualFold(c.Group, group) {
+			return c.Finders
+		}
+	}
+	return nil
+}
+
+func (c FinderChain) validate() error {
+	if (len(c.GroupKind) == 0) == (len(c.Group) == 0) {
+		return fmt.Errorf(`exactly one of "groupKind" and "group" must be set`)
+	}
+	if len(c.Finders) == 0 {
+		return fmt.Errorf("%s%s has no finders", c.GroupKind, c.Group)
+	}
+	for _, f := range c.Finders {
+		if !slices.Contains(validFinders, f) {
+			return fmt.Errorf("invalid finder %q of %s%s, valid options are: %v", f, c.GroupKind, c.Group, validFinders)
+		}
+	}
+	return nil
+}
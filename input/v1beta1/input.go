@@ -6,10 +6,13 @@ package v1beta1
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
 	"github.com/crossplane/function-sdk-go/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -27,33 +30,149 @@ type Input struct {
 
 	// +optional
 	TagFilters []TagFilter `json:"tagFilters,omitempty"`
+
+	// ExcludeTagFilters discard resources found on AWS that match any of them. Unlike TagFilters, they're not supported
+	// by the Resource Groups Tagging API, so they're applied by the function after fetching resources.
+	// +optional
+	ExcludeTagFilters []TagFilter `json:"excludeTagFilters,omitempty"`
+
+	// Include selects which desired composed resources the function manages. If empty, all AWS managed resources are
+	// included. Resources matching any of the selectors are included.
+	// +optional
+	Include []ResourceSelector `json:"include,omitempty"`
+
+	// Exclude selects desired composed resources the function must not manage, even if they're included. Resources
+	// matching any of the selectors are excluded.
+	// +optional
+	Exclude []ResourceSelector `json:"exclude,omitempty"`
+
+	// SafetyPolicy defines what happens when importing an external resource into a desired composed resource whose
+	// deletion and management policies would delete the external resource once the managed resource is deleted.
+	// Defaults to "Ignore".
+	// +kubebuilder:validation:Enum=Ignore;Warn;Refuse;ForceOrphan
+	// +optional
+	SafetyPolicy SafetyPolicy `json:"safetyPolicy,omitempty"`
+
+	// ImportMode defines how external resources are imported into desired composed resources that were never observed.
+	// Defaults to "Full".
+	// +kubebuilder:validation:Enum=Full;ObserveOnly
+	// +optional
+	ImportMode ImportMode `json:"importMode,omitempty"`
+
+	// ApprovalPath is a path on the XR approving full management of resources imported in "ObserveOnly" mode. It may
+	// point to a boolean, approving all of them, or to a list of composition names, approving only those. Approval
+	// can also be given with the "function-aws-importer.gympass.com/approve-import" annotation on the XR.
+	// +optional
+	ApprovalPath string `json:"approvalPath,omitempty"`
+
+	// AdditionalTaggableKinds extend or override the kinds the function knows to support tags. They take precedence
+	// over the function's built-in kinds and the ones given with its --taggable-kinds flag.
+	// +optional
+	AdditionalTaggableKinds []TaggableKind `json:"additionalTaggableKinds,omitempty"`
+
+	// DiscoverTaggableKinds makes the function require the CRDs of desired composed resources from Crossplane, and
+	// decide whether they support tags from the schema of the installed provider version, instead of relying on the
+	// kinds built into the function only. AdditionalTaggableKinds still take precedence.
+	// +optional
+	DiscoverTaggableKinds bool `json:"discoverTaggableKinds,omitempty"`
+
+	// KindAliases are legacy "crossplane-kind" tag values to look resources up with when their actual group-kind
+	// finds nothing, like after migrating managed resources from *.aws.upbound.io to *.aws.m.upbound.io.
+	// +optional
+	KindAliases []KindAlias `json:"kindAliases,omitempty"`
+
+	// FinderChains set the finders the external resources of given kinds are looked up with, in order. Kinds without
+	// a chain are looked up with the Tagging API, then with the natural key finders that support them. IAM kinds are
+	// looked up with the IAM API before that.
+	// +optional
+	FinderChains []FinderChain `json:"finderChains,omitempty"`
 }
 
-func (in *Input) ResolveTagFilters(xr *resource.Composite) ([]types.TagFilter, error) {
-	var filters []types.TagFilter
-	for _, tf := range in.TagFilters {
+// Manages returns true if res is included and not excluded by the input
+func (in *Input) Manages(res SelectableResource) bool {
+	if len(in.Include) > 0 && !slices.ContainsFunc(in.Include, func(sel ResourceSelector) bool { return sel.Matches(res) }) {
+		return false
+	}
+	return !slices.ContainsFunc(in.Exclude, func(sel ResourceSelector) bool { return sel.Matches(res) })
+}
+
+// ResolvedTagFilter is a TagFilter resolved against an XR, ready to be used with the Resource Groups Tagging API
+// +kubebuilder:object:generate=false
+type ResolvedTagFilter struct {
+	types.TagFilter
+	// Selector is the ResourceSelector of the originating TagFilter
+	Selector *ResourceSelector
+}
+
+// AppliesTo returns true if the filter should be used when searching for res
+func (r ResolvedTagFilter) AppliesTo(res SelectableResource) bool {
+	return r.Selector.Matches(res)
+}
+
+// ResolveTagFilters resolves TagFilters into filters that can be used with the Resource Groups Tagging API.
+// Optional filters whose path is not set on the XR are not resolved, and are returned separately instead.
+func (in *Input) ResolveTagFilters(xr *resource.Composite) (resolved []ResolvedTagFilter, skipped []TagFilter, err error) {
+	return resolveTagFilters(in.TagFilters, xr)
+}
+
+// ResolveExcludeTagFilters resolves ExcludeTagFilters the same way ResolveTagFilters does for TagFilters
+func (in *Input) ResolveExcludeTagFilters(xr *resource.Composite) (resolved []ResolvedTagFilter, skipped []TagFilter, err error) {
+	return resolveTagFilters(in.ExcludeTagFilters, xr)
+}
+
+func resolveTagFilters(tagFilters []TagFilter, xr *resource.Composite) ([]ResolvedTagFilter, []TagFilter, error) {
+	var filters []ResolvedTagFilter
+	var skipped []TagFilter
+	for _, tf := range tagFilters {
+		var resolved types.TagFilter
 		// TODO(lcaparelli): consider polymorphism if this grows larger
 		switch tf.Strategy {
 		case StrategyValue:
-			filters = append(filters, types.TagFilter{
+			resolved = types.TagFilter{
 				Key:    aws.String(tf.Key),
 				Values: []string{tf.Value},
-			})
-		case StrategyValuePath:
-			valPath := tf.ValuePath
-			resolved, err := xr.Resource.GetString(valPath)
+			}
+		case StrategyValuePath, StrategyValuesPath:
+			path := tf.path()
+			values, err := tf.valuesFromPath(xr)
+			if (fieldpath.IsNotFound(err) || (err == nil && len(values) == 0)) && tf.hasFallback() {
+				if len(tf.Default) == 0 {
+					skipped = append(skipped, tf)
+					continue
+				}
+				values, err = []string{tf.Default}, nil
+			} else if err == nil {
+				values, err = tf.Transforms.apply(values)
+			}
 			if err != nil {
-				return nil, fmt.Errorf("getting valuePath (%q) from XR: %v", valPath, err)
+				return nil, nil, fmt.Errorf("getting %s (%q) from XR: %v", tf.Strategy, path, err)
+			}
+			if len(values) == 0 {
+				return nil, nil, fmt.Errorf("%s (%q) resolved to an empty list", tf.Strategy, path)
+			}
+			if len(values) > maxTagFilterValues {
+				return nil, nil, fmt.Errorf("%s (%q) resolved to %d values, more than the maximum of %d", tf.Strategy, path, len(values), maxTagFilterValues)
 			}
-			filters = append(filters, types.TagFilter{
+			resolved = types.TagFilter{
 				Key:    aws.String(tf.Key),
-				Values: []string{resolved},
-			})
+				Values: values,
+			}
+		case StrategyValues:
+			resolved = types.TagFilter{
+				Key:    aws.String(tf.Key),
+				Values: tf.Values,
+			}
+		case StrategyExists:
+			// A tag filter with no values matches any resource that has the key, regardless of its value
+			resolved = types.TagFilter{
+				Key: aws.String(tf.Key),
+			}
 		default:
-			return nil, fmt.Errorf("invalid tag filter strategy: %q", tf.Strategy)
+			return nil, nil, fmt.Errorf("invalid tag filter strategy: %q", tf.Strategy)
 		}
+		filters = append(filters, ResolvedTagFilter{TagFilter: resolved, Selector: tf.ResourceSelector})
 	}
-	return filters, nil
+	return filters, skipped, nil
 }
 
 func (in *Input) Validate() error {
@@ -62,29 +181,187 @@ func (in *Input) Validate() error {
 			return fmt.Errorf("invalid tag filter: %v", err)
 		}
 	}
+	for _, tf := range in.ExcludeTagFilters {
+		if err := tf.validate(); err != nil {
+			return fmt.Errorf("invalid exclude tag filter: %v", err)
+		}
+	}
+	if len(in.SafetyPolicy) > 0 && !slices.Contains(validSafetyPolicies, in.SafetyPolicy) {
+		return fmt.Errorf("invalid safety policy %q, valid options are: %v", in.SafetyPolicy, validSafetyPolicies)
+	}
+	if len(in.ImportMode) > 0 && !slices.Contains(validImportModes, in.ImportMode) {
+		return fmt.Errorf("invalid import mode %q, valid options are: %v", in.ImportMode, validImportModes)
+	}
+	if len(in.ApprovalPath) > 0 && in.ImportMode != ImportModeObserveOnly {
+		return fmt.Errorf(`"approvalPath" is only supported by %q import mode`, ImportModeObserveOnly)
+	}
+	for _, k := range in.AdditionalTaggableKinds {
+		if err := k.Validate(); err != nil {
+			return fmt.Errorf("invalid additional taggable kind: %v", err)
+		}
+	}
+	for _, a := range in.KindAliases {
+		if err := a.validate(); err != nil {
+			return fmt.Errorf("invalid kind alias: %v", err)
+		}
+	}
+	for _, c := range in.FinderChains {
+		if err := c.validate(); err != nil {
+			return fmt.Errorf("invalid finder chain: %v", err)
+		}
+	}
+	for _, sel := range in.Include {
+		if err := sel.validate(); err != nil {
+			return fmt.Errorf("invalid include selector: %v", err)
+		}
+	}
+	for _, sel := range in.Exclude {
+		if err := sel.validate(); err != nil {
+			return fmt.Errorf("invalid exclude selector: %v", err)
+		}
+	}
 
 	return nil
 }
 
+type SafetyPolicy string
+
+const (
+	// SafetyPolicyIgnore imports resources regardless of their deletion and management policies
+	SafetyPolicyIgnore SafetyPolicy = "Ignore"
+	// SafetyPolicyWarn imports resources, but warns about the ones that would be deleted
+	SafetyPolicyWarn SafetyPolicy = "Warn"
+	// SafetyPolicyRefuse fails the function instead of importing resources that would be deleted
+	SafetyPolicyRefuse SafetyPolicy = "Refuse"
+	// SafetyPolicyForceOrphan sets the "Orphan" deletion policy on resources that would be deleted when importing them
+	SafetyPolicyForceOrphan SafetyPolicy = "ForceOrphan"
+)
+
+var validSafetyPolicies = []SafetyPolicy{SafetyPolicyIgnore, SafetyPolicyWarn, SafetyPolicyRefuse, SafetyPolicyForceOrphan}
+
+type ImportMode string
+
+const (
+	// ImportModeFull imports resources under full management right away
+	ImportModeFull ImportMode = "Full"
+	// ImportModeObserveOnly imports resources with the "Observe" management policy only, until full management is
+	// approved on the XR
+	ImportModeObserveOnly ImportMode = "ObserveOnly"
+)
+
+var validImportModes = []ImportMode{ImportModeFull, ImportModeObserveOnly}
+
 type Strategy string
 
 const (
 	// StrategyValue represents a static value that should be used when filtering
 	StrategyValue Strategy = "value"
-	// StrategyValuePath represents a dynamic value that will be resolved from the given path on the XR
+	// StrategyValuePath represents a dynamic value that will be resolved from the given path on the XR. Strings,
+	// numbers and booleans are converted to their string representation, while arrays result in one value per element
 	StrategyValuePath Strategy = "valuePath"
+	// StrategyValues represents a list of static values, any of which should match when filtering
+	StrategyValues Strategy = "values"
+	// StrategyValuesPath represents a list of dynamic values that will be resolved from the given path on the XR.
+	// The path must point to an array of scalars, any of which should match when filtering
+	StrategyValuesPath Strategy = "valuesPath"
+	// StrategyExists represents a filter on the tag key alone, matching resources that have the tag with any value
+	StrategyExists Strategy = "exists"
 )
 
-var validStrategies = []Strategy{StrategyValue, StrategyValuePath}
+var validStrategies = []Strategy{StrategyValue, StrategyValuePath, StrategyValues, StrategyValuesPath, StrategyExists}
+
+// maxTagFilterValues is the maximum number of values of a tag filter supported by the Resource Groups Tagging API
+const maxTagFilterValues = 20
 
 type TagFilter struct {
 	Key string `json:"key"`
-	// +kubebuilder:validation:Enum=value;valuePath
+	// +kubebuilder:validation:Enum=value;valuePath;values;valuesPath;exists
 	Strategy Strategy `json:"strategy"`
 	// +optional
 	Value string `json:"value,omitempty"`
 	// +optional
 	ValuePath string `json:"valuePath,omitempty"`
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	Values []string `json:"values,omitempty"`
+	// +optional
+	ValuesPath string `json:"valuesPath,omitempty"`
+	// Optional allows the path of a "valuePath" or "valuesPath" filter to be unset on the XR, in which case the filter
+	// is skipped instead of failing the function.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+	// Default is the value used when the path of a "valuePath" or "valuesPath" filter is unset on the XR.
+	// +optional
+	Default string `json:"default,omitempty"`
+	// Transforms are applied in order to each value resolved from the path of a "valuePath" or "valuesPath" filter.
+	// They're not applied to Default.
+	// +optional
+	Transforms Transforms `json:"transforms,omitempty"`
+	// ResourceSelector restricts which desired composed resources the filter is used for. By default, it's used for all
+	// of them.
+	// +optional
+	ResourceSelector *ResourceSelector `json:"resourceSelector,omitempty"`
+}
+
+// path returns the path on the XR the filter resolves its values from
+func (in *TagFilter) path() string {
+	if in.Strategy == StrategyValuesPath {
+		return in.ValuesPath
+	}
+	return in.ValuePath
+}
+
+// valuesFromPath reads the filter's path from the XR, converting scalars to their string representation.
+// "valuePath" accepts either a scalar or an array, while "valuesPath" requires an array.
+func (in *TagFilter) valuesFromPath(xr *resource.Composite) ([]string, error) {
+	v, err := xr.Resource.GetValue(in.path())
+	if err != nil {
+		return nil, err
+	}
+
+	arr, isArray := v.([]any)
+	if !isArray {
+		if in.Strategy == StrategyValuesPath {
+			return nil, fmt.Errorf("value is not an array: %T", v)
+		}
+		s, err := scalarToString(v)
+		if err != nil {
+			return nil, err
+		}
+		return []string{s}, nil
+	}
+
+	values := make([]string, 0, len(arr))
+	for i, e := range arr {
+		s, err := scalarToString(e)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %v", i, err)
+		}
+		values = append(values, s)
+	}
+	return values, nil
+}
+
+func scalarToString(v any) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case int:
+		return strconv.Itoa(t), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("cannot convert value of type %T to string", v)
+	}
+}
+
+// hasFallback returns true if the filter should not fail when its path is unset on the XR
+func (in *TagFilter) hasFallback() bool {
+	return in.Optional || len(in.Default) > 0
 }
 
 func (in *TagFilter) validate() error {
@@ -95,6 +372,19 @@ func (in *TagFilter) validate() error {
 		return errors.New(`"strategy" must not be empty`)
 	}
 
+	if in.hasFallback() && in.Strategy != StrategyValuePath && in.Strategy != StrategyValuesPath {
+		return fmt.Errorf(`"optional" and "default" are only supported by %q and %q strategies`, StrategyValuePath, StrategyValuesPath)
+	}
+	if len(in.Transforms) > 0 && in.Strategy != StrategyValuePath && in.Strategy != StrategyValuesPath {
+		return fmt.Errorf(`"transforms" are only supported by %q and %q strategies`, StrategyValuePath, StrategyValuesPath)
+	}
+	if err := in.Transforms.validate(); err != nil {
+		return fmt.Errorf("invalid transforms: %v", err)
+	}
+	if err := in.ResourceSelector.validate(); err != nil {
+		return fmt.Errorf("invalid resource selector: %v", err)
+	}
+
 	switch in.Strategy {
 	case StrategyValue:
 		if len(in.Value) == 0 {
@@ -104,6 +394,26 @@ func (in *TagFilter) validate() error {
 		if len(in.ValuePath) == 0 {
 			return fmt.Errorf(`using %q strategy, but "valuePath" is empty`, StrategyValuePath)
 		}
+	case StrategyValues:
+		if len(in.Values) == 0 {
+			return fmt.Errorf(`using %q strategy, but "values" is empty`, StrategyValues)
+		}
+		if len(in.Values) > maxTagFilterValues {
+			return fmt.Errorf(`using %q strategy, but "values" has %d values, more than the maximum of %d`, StrategyValues, len(in.Values), maxTagFilterValues)
+		}
+		for i, v := range in.Values {
+			if len(v) == 0 {
+				return fmt.Errorf(`using %q strategy, but "values[%d]" is empty`, StrategyValues, i)
+			}
+		}
+	case StrategyValuesPath:
+		if len(in.ValuesPath) == 0 {
+			return fmt.Errorf(`using %q strategy, but "valuesPath" is empty`, StrategyValuesPath)
+		}
+	case StrategyExists:
+		if len(in.Value) > 0 || len(in.ValuePath) > 0 || len(in.Values) > 0 || len(in.ValuesPath) > 0 {
+			return fmt.Errorf(`using %q strategy, but a value is informed. It only filters on the tag key`, StrategyExists)
+		}
 	default:
 		return fmt.Errorf("invalid strategy %q, valid options are: %v", in.Strategy, validStrategies)
 	}
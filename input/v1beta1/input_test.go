@@ -0,0 +1,559 @@
+package v1beta1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/crossplane/function-sdk-go/resource"
+	"github.com/crossplane/function-sdk-go/resource/composite"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRunInputSuite(t *testing.T) {
+	suite.Run(t, &inputSuite{})
+}
+
+type inputSuite struct {
+	suite.Suite
+	xr *resource.Composite
+}
+
+func (s *inputSuite) SetupTest() {
+	xr := composite.New()
+	s.Require().NoError(xr.UnmarshalJSON([]byte(`
+		{
+			"apiVersion": "acme.io/v1beta1",
+			"kind": "XSomeResource",
+			"metadata": {
+				"name": "test"
+			},
+			"spec": {
+				"environment": "prod",
+				"teams": ["foo", "bar"],
+				"empty": [],
+				"port": 5432,
+				"ratio": 0.5,
+				"enabled": true,
+				"ports": [80, 443],
+				"object": {"foo": "bar"},
+				"name": "  My-Team-prod  "
+			}
+		}`)))
+	s.xr = &resource.Composite{Resource: xr}
+}
+
+func unwrap(filters []ResolvedTagFilter) []types.TagFilter {
+	var result []types.TagFilter
+	for _, f := range filters {
+		result = append(result, f.TagFilter)
+	}
+	return result
+}
+
+func (s *inputSuite) TestResolveTagFilters_ValidStrategies_ShouldResolveFilters() {
+	testCases := []struct {
+		name string
+		tf   TagFilter
+		want types.TagFilter
+	}{
+		{
+			name: "value",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValue, Value: "value"},
+			want: types.TagFilter{Key: aws.String("key"), Values: []string{"value"}},
+		},
+		{
+			name: "valuePath",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.environment"},
+			want: types.TagFilter{Key: aws.String("key"), Values: []string{"prod"}},
+		},
+		{
+			name: "values",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValues, Values: []string{"a", "b"}},
+			want: types.TagFilter{Key: aws.String("key"), Values: []string{"a", "b"}},
+		},
+		{
+			name: "valuesPath",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuesPath, ValuesPath: "spec.teams"},
+			want: types.TagFilter{Key: aws.String("key"), Values: []string{"foo", "bar"}},
+		},
+		{
+			name: "exists",
+			tf:   TagFilter{Key: "key", Strategy: StrategyExists},
+			want: types.TagFilter{Key: aws.String("key")},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{TagFilters: []TagFilter{tc.tf}}
+			s.Require().NoError(in.Validate())
+
+			got, skipped, err := in.ResolveTagFilters(s.xr)
+
+			s.NoError(err)
+			s.Empty(skipped)
+			s.Equal([]types.TagFilter{tc.want}, unwrap(got))
+		})
+	}
+}
+
+func (s *inputSuite) TestResolveTagFilters_UnresolvableValuesPath_ShouldFail() {
+	testCases := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "Path does not exist",
+			path: "spec.doesNotExist",
+		},
+		{
+			name: "Path is not an array",
+			path: "spec.environment",
+		},
+		{
+			name: "Path is an empty array",
+			path: "spec.empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{TagFilters: []TagFilter{{Key: "key", Strategy: StrategyValuesPath, ValuesPath: tc.path}}}
+
+			got, skipped, err := in.ResolveTagFilters(s.xr)
+
+			s.Error(err)
+			s.Nil(got)
+			s.Nil(skipped)
+		})
+	}
+}
+
+func (s *inputSuite) TestResolveTagFilters_UnsetPathWithFallback_ShouldSkipOrDefault() {
+	testCases := []struct {
+		name        string
+		tf          TagFilter
+		wantFilters []types.TagFilter
+		wantSkipped bool
+	}{
+		{
+			name:        "Optional valuePath is skipped",
+			tf:          TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.doesNotExist", Optional: true},
+			wantSkipped: true,
+		},
+		{
+			name:        "Optional valuesPath is skipped",
+			tf:          TagFilter{Key: "key", Strategy: StrategyValuesPath, ValuesPath: "spec.doesNotExist", Optional: true},
+			wantSkipped: true,
+		},
+		{
+			name:        "Optional valuesPath pointing to an empty array is skipped",
+			tf:          TagFilter{Key: "key", Strategy: StrategyValuesPath, ValuesPath: "spec.empty", Optional: true},
+			wantSkipped: true,
+		},
+		{
+			name:        "valuePath with default uses the default",
+			tf:          TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.doesNotExist", Default: "dev"},
+			wantFilters: []types.TagFilter{{Key: aws.String("key"), Values: []string{"dev"}}},
+		},
+		{
+			name:        "valuesPath with default uses the default",
+			tf:          TagFilter{Key: "key", Strategy: StrategyValuesPath, ValuesPath: "spec.doesNotExist", Default: "dev", Optional: true},
+			wantFilters: []types.TagFilter{{Key: aws.String("key"), Values: []string{"dev"}}},
+		},
+		{
+			name:        "Optional valuePath that is set is resolved",
+			tf:          TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.environment", Default: "dev"},
+			wantFilters: []types.TagFilter{{Key: aws.String("key"), Values: []string{"prod"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{TagFilters: []TagFilter{tc.tf}}
+			s.Require().NoError(in.Validate())
+
+			got, skipped, err := in.ResolveTagFilters(s.xr)
+
+			s.NoError(err)
+			s.Equal(tc.wantFilters, unwrap(got))
+			if tc.wantSkipped {
+				s.Equal([]TagFilter{tc.tf}, skipped)
+			} else {
+				s.Empty(skipped)
+			}
+		})
+	}
+}
+
+func (s *inputSuite) TestTooManyTagFilterValues_ShouldFail() {
+	values := make([]any, 0, maxTagFilterValues+1)
+	strs := make([]string, 0, maxTagFilterValues+1)
+	for i := range maxTagFilterValues + 1 {
+		values = append(values, fmt.Sprintf("v%d", i))
+		strs = append(strs, fmt.Sprintf("v%d", i))
+	}
+
+	in := &Input{TagFilters: []TagFilter{{Key: "key", Strategy: StrategyValues, Values: strs}}}
+	s.Error(in.Validate())
+
+	in.TagFilters[0].Values = strs[:maxTagFilterValues]
+	s.NoError(in.Validate())
+
+	s.Require().NoError(s.xr.Resource.SetValue("spec.many", values))
+	in = &Input{TagFilters: []TagFilter{{Key: "key", Strategy: StrategyValuesPath, ValuesPath: "spec.many"}}}
+	s.Require().NoError(in.Validate())
+
+	_, _, err := in.ResolveTagFilters(s.xr)
+	s.Error(err)
+}
+
+func (s *inputSuite) TestValidate_FallbackOnStaticStrategy_ShouldFail() {
+	in := &Input{TagFilters: []TagFilter{{Key: "key", Strategy: StrategyValue, Value: "value", Optional: true}}}
+
+	s.Error(in.Validate())
+}
+
+func (s *inputSuite) TestResolveTagFilters_NonStringPaths_ShouldConvertToString() {
+	testCases := []struct {
+		name string
+		tf   TagFilter
+		want []string
+	}{
+		{
+			name: "Integer",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.port"},
+			want: []string{"5432"},
+		},
+		{
+			name: "Float",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.ratio"},
+			want: []string{"0.5"},
+		},
+		{
+			name: "Boolean",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.enabled"},
+			want: []string{"true"},
+		},
+		{
+			name: "Array on valuePath",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.teams"},
+			want: []string{"foo", "bar"},
+		},
+		{
+			name: "Array of integers on valuesPath",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuesPath, ValuesPath: "spec.ports"},
+			want: []string{"80", "443"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{TagFilters: []TagFilter{tc.tf}}
+			s.Require().NoError(in.Validate())
+
+			got, _, err := in.ResolveTagFilters(s.xr)
+
+			s.NoError(err)
+			s.Equal([]types.TagFilter{{Key: aws.String("key"), Values: tc.want}}, unwrap(got))
+		})
+	}
+}
+
+func (s *inputSuite) TestResolveTagFilters_ObjectPath_ShouldFail() {
+	in := &Input{TagFilters: []TagFilter{{Key: "key", Strategy: StrategyValuePath, ValuePath: "spec.object"}}}
+
+	_, _, err := in.ResolveTagFilters(s.xr)
+
+	s.Error(err)
+}
+
+func (s *inputSuite) TestResolveTagFilters_Transforms_ShouldApplyInOrder() {
+	in := &Input{TagFilters: []TagFilter{{
+		Key:       "key",
+		Strategy:  StrategyValuePath,
+		ValuePath: "spec.name",
+		Transforms: Transforms{
+			{Type: TransformTypeTrim},
+			{Type: TransformTypeLowercase},
+			{Type: TransformTypeRegexp, Regexp: &RegexpTransform{Match: `^(.*)-prod$`, Replace: "$1"}},
+			{Type: TransformTypeTrimPrefix, Trim: "my-"},
+			{Type: TransformTypeFormat, Format: "team-%s"},
+			{Type: TransformTypeFormat, Format: "%-10v%%"},
+		},
+	}}}
+	s.Require().NoError(in.Validate())
+
+	got, _, err := in.ResolveTagFilters(s.xr)
+
+	s.NoError(err)
+	s.Equal([]types.TagFilter{{Key: aws.String("key"), Values: []string{"team-team %"}}}, unwrap(got))
+}
+
+func (s *inputSuite) TestResolveTagFilters_TransformsResultInEmptyValue_ShouldFail() {
+	in := &Input{TagFilters: []TagFilter{{
+		Key:        "key",
+		Strategy:   StrategyValuePath,
+		ValuePath:  "spec.environment",
+		Transforms: Transforms{{Type: TransformTypeTrimPrefix, Trim: "prod"}},
+	}}}
+	s.Require().NoError(in.Validate())
+
+	_, _, err := in.ResolveTagFilters(s.xr)
+
+	s.Error(err)
+}
+
+func (s *inputSuite) TestValidate_InvalidTransforms_ShouldFail() {
+	testCases := []struct {
+		name string
+		tf   TagFilter
+	}{
+		{
+			name: "Transforms on static strategy",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValue, Value: "v", Transforms: Transforms{{Type: TransformTypeLowercase}}},
+		},
+		{
+			name: "Invalid transform type",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: "invalid"}}},
+		},
+		{
+			name: "Regexp transform without regexp",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeRegexp}}},
+		},
+		{
+			name: "Regexp transform with invalid regexp",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeRegexp, Regexp: &RegexpTransform{Match: "("}}}},
+		},
+		{
+			name: "TrimPrefix transform without trim",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeTrimPrefix}}},
+		},
+		{
+			name: "Format transform without fmt",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeFormat}}},
+		},
+		{
+			name: "Format transform without verbs",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeFormat, Format: "100%% static"}}},
+		},
+		{
+			name: "Format transform with several verbs",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeFormat, Format: "%s-%s"}}},
+		},
+		{
+			name: "Format transform with a non-string verb",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeFormat, Format: "team-%d"}}},
+		},
+		{
+			name: "Format transform without a verb after its width",
+			tf:   TagFilter{Key: "key", Strategy: StrategyValuePath, ValuePath: "p", Transforms: Transforms{{Type: TransformTypeFormat, Format: "team-%5"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{TagFilters: []TagFilter{tc.tf}}
+
+			s.Error(in.Validate())
+		})
+	}
+}
+
+type fakeSelectableResource struct {
+	compositionName string
+	groupKind       string
+	labels          map[string]string
+}
+
+func (f fakeSelectableResource) CompositionName() string   { return f.compositionName }
+func (f fakeSelectableResource) GroupKind() string         { return f.groupKind }
+func (f fakeSelectableResource) Labels() map[string]string { return f.labels }
+
+func (s *inputSuite) TestResourceSelector_Matches() {
+	sg := fakeSelectableResource{
+		compositionName: "securityGroup",
+		groupKind:       "securitygroup.ec2.aws.upbound.io",
+		labels:          map[string]string{"tier": "network"},
+	}
+	rule := fakeSelectableResource{
+		compositionName: "test-r0-b0-ipv4",
+		groupKind:       "securitygroupingressrule.ec2.aws.upbound.io",
+	}
+
+	testCases := []struct {
+		name     string
+		sel      *ResourceSelector
+		wantSG   bool
+		wantRule bool
+	}{
+		{
+			name:     "Nil selector matches everything",
+			wantSG:   true,
+			wantRule: true,
+		},
+		{
+			name:     "Empty selector matches everything",
+			sel:      &ResourceSelector{},
+			wantSG:   true,
+			wantRule: true,
+		},
+		{
+			name:   "Composition name",
+			sel:    &ResourceSelector{CompositionNames: []string{"securityGroup"}},
+			wantSG: true,
+		},
+		{
+			name:     "Composition name glob",
+			sel:      &ResourceSelector{CompositionNames: []string{"test-*-ipv4"}},
+			wantRule: true,
+		},
+		{
+			name:     "GroupKind",
+			sel:      &ResourceSelector{GroupKinds: []string{"SecurityGroupIngressRule.ec2.aws.upbound.io"}},
+			wantRule: true,
+		},
+		{
+			name:   "Label selector",
+			sel:    &ResourceSelector{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "network"}}},
+			wantSG: true,
+		},
+		{
+			name: "All criteria must match",
+			sel: &ResourceSelector{
+				CompositionNames: []string{"securityGroup"},
+				GroupKinds:       []string{"securitygroupingressrule.ec2.aws.upbound.io"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.wantSG, tc.sel.Matches(sg))
+			s.Equal(tc.wantRule, tc.sel.Matches(rule))
+		})
+	}
+}
+
+func (s *inputSuite) TestValidate_InvalidResourceSelector_ShouldFail() {
+	testCases := []struct {
+		name string
+		sel  *ResourceSelector
+	}{
+		{
+			name: "Invalid composition name pattern",
+			sel:  &ResourceSelector{CompositionNames: []string{"["}},
+		},
+		{
+			name: "Invalid label selector",
+			sel: &ResourceSelector{LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "tier",
+				Operator: "invalid",
+			}}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{TagFilters: []TagFilter{{Key: "key", Strategy: StrategyValue, Value: "v", ResourceSelector: tc.sel}}}
+
+			s.Error(in.Validate())
+		})
+	}
+}
+
+func (s *inputSuite) TestKindAliasesFor_ShouldListGroupKindAliasesFirst() {
+	in := &Input{KindAliases: []KindAlias{
+		{Group: "ec2.aws.m.upbound.io", Aliases: []string{"ec2.aws.upbound.io"}},
+		{GroupKind: "SecurityGroup.ec2.aws.m.upbound.io", Aliases: []string{"securitygroups.ec2.aws.upbound.io", "SecurityGroup.ec2.aws.upbound.io"}},
+		{GroupKind: "vpc.ec2.aws.m.upbound.io", Aliases: []string{"vpcs.ec2.aws.upbound.io"}},
+	}}
+
+	s.Equal([]string{"securitygroups.ec2.aws.upbound.io", "securitygroup.ec2.aws.upbound.io"}, in.KindAliasesFor("securitygroup.ec2.aws.m.upbound.io"))
+	s.Equal([]string{"subnet.ec2.aws.upbound.io"}, in.KindAliasesFor("subnet.ec2.aws.m.upbound.io"))
+	s.Empty(in.KindAliasesFor("securitygroup.ec2.aws.upbound.io"))
+}
+
+func (s *inputSuite) TestValidate_InvalidKindAlias_ShouldFail() {
+	testCases := []struct {
+		name  string
+		alias KindAlias
+	}{
+		{
+			name:  "Neither group-kind nor group",
+			alias: KindAlias{Aliases: []string{"ec2.aws.upbound.io"}},
+		},
+		{
+			name:  "Both group-kind and group",
+			alias: KindAlias{GroupKind: "vpc.ec2.aws.m.upbound.io", Group: "ec2.aws.m.upbound.io", Aliases: []string{"ec2.aws.upbound.io"}},
+		},
+		{
+			name:  "No aliases",
+			alias: KindAlias{Group: "ec2.aws.m.upbound.io"},
+		},
+		{
+			name:  "Group-kind alias without group",
+			alias: KindAlias{GroupKind: "vpc.ec2.aws.m.upbound.io", Aliases: []string{"vpc"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{KindAliases: []KindAlias{tc.alias}}
+
+			s.Error(in.Validate())
+		})
+	}
+}
+
+func (s *inputSuite) TestFindersFor_ShouldPreferGroupKindChains() {
+	in := &Input{FinderChains: []FinderChain{
+		{Group: "ec2.aws.upbound.io", Finders: []Finder{FinderTaggingAPI}},
+		{GroupKind: "SecurityGroup.ec2.aws.upbound.io", Finders: []Finder{FinderSecurityGroupNaturalKey, FinderTaggingAPI}},
+	}}
+
+	s.Equal([]Finder{FinderSecurityGroupNaturalKey, FinderTaggingAPI}, in.FindersFor("securitygroup.ec2.aws.upbound.io"))
+	s.Equal([]Finder{FinderTaggingAPI}, in.FindersFor("vpc.ec2.aws.upbound.io"))
+	s.Nil(in.FindersFor("zone.route53.aws.upbound.io"))
+}
+
+func (s *inputSuite) TestValidate_InvalidFinderChain_ShouldFail() {
+	testCases := []struct {
+		name  string
+		chain FinderChain
+	}{
+		{
+			name:  "Neither group-kind nor group",
+			chain: FinderChain{Finders: []Finder{FinderTaggingAPI}},
+		},
+		{
+			name:  "Both group-kind and group",
+			chain: FinderChain{GroupKind: "vpc.ec2.aws.upbound.io", Group: "ec2.aws.upbound.io", Finders: []Finder{FinderTaggingAPI}},
+		},
+		{
+			name:  "No finders",
+			chain: FinderChain{Group: "ec2.aws.upbound.io"},
+		},
+		{
+			name:  "Unknown finder",
+			chain: FinderChain{Group: "ec2.aws.upbound.io", Finders: []Finder{"Magic"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			in := &Input{FinderChains: []FinderChain{tc.chain}}
+
+			s.Error(in.Validate())
+		})
+	}
+}
+
+func (s *inputSuite) TestDefaultFinders_ShouldUseIAMFirstForIAMKinds() {
+	s.Equal([]Finder{FinderIAM, FinderTaggingAPI, FinderSecurityGroupNaturalKey, FinderHostedZoneNaturalKey}, DefaultFinders("role.iam.aws.upbound.io"))
+	s.Equal([]Finder{FinderIAM, FinderTaggingAPI, FinderSecurityGroupNaturalKey, FinderHostedZoneNaturalKey}, DefaultFinders("role.iam.aws.m.upbound.io"))
+	s.Equal([]Finder{FinderTaggingAPI, FinderSecurityGroupNaturalKey, FinderHostedZoneNaturalKey}, DefaultFinders("securitygroup.ec2.aws.upbound.io"))
+}
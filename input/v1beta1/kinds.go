@@ -0,0 +1,76 @@
+package v1beta1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TaggableKind extends or overrides the kinds the function knows to support tags, without rebuilding the function.
+type TaggableKind struct {
+	// GroupKind of the managed resource, as "<kind>.<group>" (eg, "securitygroup.ec2.aws.upbound.io"). Case-insensitive.
+	GroupKind string `json:"groupKind"`
+
+	// TagsField is the field holding the kind's tags in .spec.forProvider and .spec.initProvider. Defaults to "tags".
+	// +optional
+	TagsField string `json:"tagsField,omitempty"`
+
+	// TagsShape is how the kind models its tags. Map is a map of tag keys to values, List is a list of objects with
+	// "key" and "value" fields. Defaults to "Map".
+	// +kubebuilder:validation:Enum=Map;List
+	// +optional
+	TagsShape TagsShape `json:"tagsShape,omitempty"`
+
+	// Disabled stops the function from tagging the kind, even if it's known to support tags.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+type TagsShape string
+
+const (
+	// TagsShapeMap is a map of tag keys to values, eg: tags: {foo: bar}
+	TagsShapeMap TagsShape = "Map"
+	// TagsShapeList is a list of objects with "key" and "value" fields, eg: tag: [{key: foo, value: bar}]
+	TagsShapeList TagsShape = "List"
+)
+
+var validTagsShapes = []TagsShape{TagsShapeMap, TagsShapeList}
+
+// NormalizedGroupKind returns the lower-case group-kind
+func (k TaggableKind) NormalizedGroupKind() string {
+	return strings.ToLower(k.GroupKind)
+}
+
+// Field returns the tags field, or its default
+func (k TaggableKind) Field() string {
+	if len(k.TagsField) == 0 {
+		return "tags"
+	}
+	return k.TagsField
+}
+
+// Shape returns the tags shape, or its default
+func (k TaggableKind) Shape() TagsShape {
+	if len(k.TagsShape) == 0 {
+		return TagsShapeMap
+	}
+	return k.TagsShape
+}
+
+// Validate returns an error if the taggable kind is invalid
+func (k TaggableKind) Validate() error {
+	if len(k.GroupKind) == 0 {
+		return fmt.Errorf(`"groupKind" is required`)
+	}
+	if !strings.Contains(k.GroupKind, ".") {
+		return fmt.Errorf(`invalid group-kind %q, expected "<kind>.<group>"`, k.GroupKind)
+	}
+	if strings.Contains(k.Field(), ".") {
+		return fmt.Errorf(`invalid tags field %q of %s, it must be a field directly under .spec.forProvider`, k.TagsField, k.GroupKind)
+	}
+	if !slices.Contains(validTagsShapes, k.Shape()) {
+		return fmt.Errorf("invalid tags shape %q of %s, valid options are: %v", k.TagsShape, k.GroupKind, validTagsShapes)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package v1beta1
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SelectableResource is a desired composed resource that can be matched by a ResourceSelector
+// +kubebuilder:object:generate=false
+type SelectableResource interface {
+	CompositionName() string
+	GroupKind() string
+	Labels() map[string]string
+}
+
+// ResourceSelector selects desired composed resources. A resource is selected if it matches all informed criteria,
+// and an empty selector selects all resources.
+type ResourceSelector struct {
+	// CompositionNames are the names of resources as defined in the composition. Shell glob patterns are supported
+	// (eg, "test-*-ipv4"). Matches if any of them matches.
+	// +optional
+	CompositionNames []string `json:"compositionNames,omitempty"`
+	// GroupKinds are lower-case group-kinds (eg, "securitygroup.ec2.aws.upbound.io"). Matches if any of them matches.
+	// +optional
+	GroupKinds []string `json:"groupKinds,omitempty"`
+	// LabelSelector matches labels on the desired composed resource
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// Matches returns true if res is selected by the selector. A nil selector selects all resources.
+func (in *ResourceSelector) Matches(res SelectableResource) bool {
+	if in == nil {
+		return true
+	}
+
+	if len(in.CompositionNames) > 0 && !slices.ContainsFunc(in.CompositionNames, func(pattern string) bool {
+		match, _ := path.Match(pattern, res.CompositionName())
+		return match
+	}) {
+		return false
+	}
+
+	if len(in.GroupKinds) > 0 && !slices.ContainsFunc(in.GroupKinds, func(gk string) bool {
+		return strings.EqualFold(gk, res.GroupKind())
+	}) {
+		return false
+	}
+
+	if in.LabelSelector != nil {
+		// validated beforehand, the error can't happen
+		sel, _ := metav1.LabelSelectorAsSelector(in.LabelSelector)
+		if !sel.Matches(labels.Set(res.Labels())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (in *ResourceSelector) validate() error {
+	if in == nil {
+		return nil
+	}
+
+	for _, pattern := range in.CompositionNames {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid composition name pattern %q: %v", pattern, err)
+		}
+	}
+
+	if in.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(in.LabelSelector); err != nil {
+			return fmt.Errorf("invalid label selector: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package v1beta1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+type TransformType string
+
+const (
+	// TransformTypeLowercase converts the value to lower case
+	TransformTypeLowercase TransformType = "Lowercase"
+	// TransformTypeUppercase converts the value to upper case
+	TransformTypeUppercase TransformType = "Uppercase"
+	// TransformTypeTrim removes leading and trailing white space from the value
+	TransformTypeTrim TransformType = "Trim"
+	// TransformTypeTrimPrefix removes the given prefix from the value, if present
+	TransformTypeTrimPrefix TransformType = "TrimPrefix"
+	// TransformTypeTrimSuffix removes the given suffix from the value, if present
+	TransformTypeTrimSuffix TransformType = "TrimSuffix"
+	// TransformTypeRegexp replaces matches of a regular expression in the value
+	TransformTypeRegexp TransformType = "Regexp"
+	// TransformTypeFormat formats the value using a Go format string, like fmt.Sprintf
+	TransformTypeFormat TransformType = "Format"
+)
+
+var validTransformTypes = []TransformType{
+	TransformTypeLowercase,
+	TransformTypeUppercase,
+	TransformTypeTrim,
+	TransformTypeTrimPrefix,
+	TransformTypeTrimSuffix,
+	TransformTypeRegexp,
+	TransformTypeFormat,
+}
+
+// Transforms is a pipeline of string transforms, applied in order
+type Transforms []Transform
+
+// Transform changes a value resolved from the XR before it's used in a tag filter
+type Transform struct {
+	// +kubebuilder:validation:Enum=Lowercase;Uppercase;Trim;TrimPrefix;TrimSuffix;Regexp;Format
+	Type TransformType `json:"type"`
+	// Trim is the prefix or suffix removed by "TrimPrefix" and "TrimSuffix" transforms
+	// +optional
+	Trim string `json:"trim,omitempty"`
+	// Regexp configures a "Regexp" transform
+	// +optional
+	Regexp *RegexpTransform `json:"regexp,omitempty"`
+	// Format is the format string used by "Format" transforms. It must contain a single verb, like "%s"
+	// +optional
+	Format string `json:"fmt,omitempty"`
+}
+
+// RegexpTransform replaces all matches of Match with Replace, which may reference capture groups (eg, "$1")
+type RegexpTransform struct {
+	Match string `json:"match"`
+	// +optional
+	Replace string `json:"replace,omitempty"`
+}
+
+func (t Transforms) apply(values []string) ([]string, error) {
+	if len(t) == 0 {
+		return values, nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		for i, tr := range t {
+			var err error
+			v, err = tr.apply(v)
+			if err != nil {
+				return nil, fmt.Errorf("transform %d (%s): %v", i, tr.Type, err)
+			}
+		}
+		if len(v) == 0 {
+			return nil, errors.New("transforms resulted in an empty value")
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func (t Transforms) validate() error {
+	for i, tr := range t {
+		if err := tr.validate(); err != nil {
+			return fmt.Errorf("transform %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (t Transform) apply(v string) (string, error) {
+	switch t.Type {
+	case TransformTypeLowercase:
+		return strings.ToLower(v), nil
+	case TransformTypeUppercase:
+		return strings.ToUpper(v), nil
+	case TransformTypeTrim:
+		return strings.TrimSpace(v), nil
+	case TransformTypeTrimPrefix:
+		return strings.TrimPrefix(v, t.Trim), nil
+	case TransformTypeTrimSuffix:
+		return strings.TrimSuffix(v, t.Trim), nil
+	case TransformTypeRegexp:
+		re, err := regexp.Compile(t.Regexp.Match)
+		if err != nil {
+			return "", fmt.Errorf("compiling regexp %q: %v", t.Regexp.Match, err)
+		}
+		return re.ReplaceAllString(v, t.Regexp.Replace), nil
+	case TransformTypeFormat:
+		return fmt.Sprintf(t.Format, v), nil
+	default:
+		return "", fmt.Errorf("invalid transform type: %q", t.Type)
+	}
+}
+
+// formatVerbs returns the verbs of a Go format string, skipping their flags, width and precision, and ignoring "%%"
+// escapes. A verb missing at the end of the string is returned as '%'.
+func formatVerbs(format string) []byte {
+	var verbs []byte
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0123456789.", format[i]) >= 0 {
+			i++
+		}
+		if i == len(format) {
+			verbs = append(verbs, '%')
+			break
+		}
+		if format[i] != '%' {
+			verbs = append(verbs, format[i])
+		}
+	}
+	return verbs
+}
+
+func (t Transform) validate() error {
+	switch t.Type {
+	case TransformTypeLowercase, TransformTypeUppercase, TransformTypeTrim:
+	case TransformTypeTrimPrefix, TransformTypeTrimSuffix:
+		if len(t.Trim) == 0 {
+			return fmt.Errorf(`using %q transform, but "trim" is empty`, t.Type)
+		}
+	case TransformTypeRegexp:
+		if t.Regexp == nil || len(t.Regexp.Match) == 0 {
+			return fmt.Errorf(`using %q transform, but "regexp.match" is empty`, t.Type)
+		}
+		if _, err := regexp.Compile(t.Regexp.Match); err != nil {
+			return fmt.Errorf(`using %q transform, but "regexp.match" is invalid: %v`, t.Type, err)
+		}
+	case TransformTypeFormat:
+		if len(t.Format) == 0 {
+			return errors.New(`using "Format" transform, but "fmt" is empty`)
+		}
+		verbs := formatVerbs(t.Format)
+		if len(verbs) != 1 {
+			return fmt.Errorf(`using "Format" transform, but "fmt" has %d verbs instead of one`, len(verbs))
+		}
+		if verbs[0] != 's' && verbs[0] != 'v' {
+			return fmt.Errorf(`using "Format" transform, but "fmt" has a %%%c verb instead of %%s or %%v`, verbs[0])
+		}
+	default:
+		return fmt.Errorf("invalid transform type %q, valid options are: %v", t.Type, validTransformTypes)
+	}
+	return nil
+}
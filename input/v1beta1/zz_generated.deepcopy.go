@@ -5,9 +5,30 @@
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FinderChain) DeepCopyInto(out *FinderChain) {
+	*out = *in
+	if in.Finders != nil {
+		in, out := &in.Finders, &out.Finders
+		*out = make([]Finder, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FinderChain.
+func (in *FinderChain) DeepCopy() *FinderChain {
+	if in == nil {
+		return nil
+	}
+	out := new(FinderChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Input) DeepCopyInto(out *Input) {
 	*out = *in
@@ -16,8 +37,50 @@ func (in *Input) DeepCopyInto(out *Input) {
 	if in.TagFilters != nil {
 		in, out := &in.TagFilters, &out.TagFilters
 		*out = make([]TagFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludeTagFilters != nil {
+		in, out := &in.ExcludeTagFilters, &out.ExcludeTagFilters
+		*out = make([]TagFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]ResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]ResourceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalTaggableKinds != nil {
+		in, out := &in.AdditionalTaggableKinds, &out.AdditionalTaggableKinds
+		*out = make([]TaggableKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.KindAliases != nil {
+		in, out := &in.KindAliases, &out.KindAliases
+		*out = make([]KindAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FinderChains != nil {
+		in, out := &in.FinderChains, &out.FinderChains
+		*out = make([]FinderChain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Input.
@@ -38,9 +101,91 @@ func (in *Input) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KindAlias) DeepCopyInto(out *KindAlias) {
+	*out = *in
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KindAlias.
+func (in *KindAlias) DeepCopy() *KindAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(KindAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexpTransform) DeepCopyInto(out *RegexpTransform) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexpTransform.
+func (in *RegexpTransform) DeepCopy() *RegexpTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexpTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	if in.CompositionNames != nil {
+		in, out := &in.CompositionNames, &out.CompositionNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupKinds != nil {
+		in, out := &in.GroupKinds, &out.GroupKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TagFilter) DeepCopyInto(out *TagFilter) {
 	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Transforms != nil {
+		in, out := &in.Transforms, &out.Transforms
+		*out = make(Transforms, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceSelector != nil {
+		in, out := &in.ResourceSelector, &out.ResourceSelector
+		*out = new(ResourceSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagFilter.
@@ -52,3 +197,59 @@ func (in *TagFilter) DeepCopy() *TagFilter {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaggableKind) DeepCopyInto(out *TaggableKind) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaggableKind.
+func (in *TaggableKind) DeepCopy() *TaggableKind {
+	if in == nil {
+		return nil
+	}
+	out := new(TaggableKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Transform) DeepCopyInto(out *Transform) {
+	*out = *in
+	if in.Regexp != nil {
+		in, out := &in.Regexp, &out.Regexp
+		*out = new(RegexpTransform)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transform.
+func (in *Transform) DeepCopy() *Transform {
+	if in == nil {
+		return nil
+	}
+	out := new(Transform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Transforms) DeepCopyInto(out *Transforms) {
+	{
+		in := &in
+		*out = make(Transforms, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transforms.
+func (in Transforms) DeepCopy() Transforms {
+	if in == nil {
+		return nil
+	}
+	out := new(Transforms)
+	in.DeepCopyInto(out)
+	return *out
+}
@@ -0,0 +1,167 @@
+// Package inventory serves Resource Groups Tagging API lookups from a local file, so the function can run without AWS,
+// like when rendering compositions offline.
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultResourcesPerPage is the Tagging API's default, and maximum, page size
+const defaultResourcesPerPage = 100
+
+var _ resourcegroupstaggingapi.GetResourcesAPIClient = &Client{}
+
+// Inventory lists the external resources the Client serves, as YAML or JSON
+type Inventory struct {
+	Resources []Resource `json:"resources"`
+}
+
+// Resource is an external resource in the inventory
+type Resource struct {
+	ARN  string            `json:"arn"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Client serves GetResources from an inventory, following the Tagging API's semantics: resources must match all tag
+// filters and, if any, one of the resource type filters. Results are paginated.
+type Client struct {
+	resources []Resource
+}
+
+// Load reads the inventory file at path
+func Load(path string) (*Client, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading inventory file: %v", err)
+	}
+
+	var inv Inventory
+	if err := yaml.UnmarshalStrict(data, &inv); err != nil {
+		return nil, fmt.Errorf("parsing inventory file: %v", err)
+	}
+	return New(inv)
+}
+
+// New returns a Client serving the resources of inv, which must have unique ARNs
+func New(inv Inventory) (*Client, error) {
+	seen := map[string]bool{}
+	for i, r := range inv.Resources {
+		if len(r.ARN) == 0 {
+			return nil, fmt.Errorf("resource %d has no ARN", i)
+		}
+		if _, _, ok := resourceType(r.ARN); !ok {
+			return nil, fmt.Errorf("resource %d has an invalid ARN: %q", i, r.ARN)
+		}
+		if seen[r.ARN] {
+			return nil, fmt.Errorf("resource %d has a duplicate ARN: %q", i, r.ARN)
+		}
+		seen[r.ARN] = true
+	}
+	return &Client{resources: inv.Resources}, nil
+}
+
+func (c *Client) GetResources(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, opts ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	var matches []types.ResourceTagMapping
+	for _, r := range c.resources {
+		if matchesResourceTypeFilters(r.ARN, input.ResourceTypeFilters) && matchesTagFilters(r.Tags, input.TagFilters) {
+			matches = append(matches, r.tagMapping())
+		}
+	}
+
+	start := 0
+	if token := aws.ToString(input.PaginationToken); len(token) > 0 {
+		var err error
+		start, err = strconv.Atoi(token)
+		if err != nil || start < 0 || start > len(matches) {
+			return nil, fmt.Errorf("invalid pagination token %q", token)
+		}
+	}
+
+	perPage := int(aws.ToInt32(input.ResourcesPerPage))
+	if perPage <= 0 {
+		perPage = defaultResourcesPerPage
+	}
+
+	end := min(start+perPage, len(matches))
+	out := &resourcegroupstaggingapi.GetResourcesOutput{ResourceTagMappingList: matches[start:end]}
+	if end < len(matches) {
+		out.PaginationToken = aws.String(strconv.Itoa(end))
+	}
+	return out, nil
+}
+
+func (r Resource) tagMapping() types.ResourceTagMapping {
+	keys := make([]string, 0, len(r.Tags))
+	for k := range r.Tags {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	tags := make([]types.Tag, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(r.Tags[k])})
+	}
+	return types.ResourceTagMapping{ResourceARN: aws.String(r.ARN), Tags: tags}
+}
+
+// matchesTagFilters returns true if tags match all filters: a filter matches if its key is present and, when it has
+// values, the tag's value is one of them
+func matchesTagFilters(tags map[string]string, filters []types.TagFilter) bool {
+	for _, f := range filters {
+		v, ok := tags[aws.ToString(f.Key)]
+		if !ok {
+			return false
+		}
+		if len(f.Values) > 0 && !slices.Contains(f.Values, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesResourceTypeFilters returns true if there are no filters, or if arn is of any of the given resource types,
+// "<service>[:<type>]"
+func matchesResourceTypeFilters(arn string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	service, resType, _ := resourceType(arn)
+	for _, f := range filters {
+		filterService, filterType, hasType := strings.Cut(f, ":")
+		if filterService == service && (!hasType || filterType == resType) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceType returns the service and resource type of arn, which looks like
+// "arn:partition:service:region:account-id:resource-type/resource-id", or with a ":" before the resource ID. The
+// resource type is empty for ARNs without one, like "arn:aws:s3:::some-bucket".
+func resourceType(arn string) (service, resType string, ok bool) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" {
+		return "", "", false
+	}
+
+	resource := parts[5]
+	resType, _, found := strings.Cut(resource, "/")
+	if !found {
+		resType, _, found = strings.Cut(resource, ":")
+	}
+	if !found {
+		resType = ""
+	}
+	return parts[2], resType, true
+}
@@ -0,0 +1,149 @@
+package inventory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRunInventorySuite(t *testing.T) {
+	suite.Run(t, &inventorySuite{})
+}
+
+type inventorySuite struct {
+	suite.Suite
+	client *Client
+}
+
+func (s *inventorySuite) SetupTest() {
+	var err error
+	s.client, err = New(Inventory{Resources: []Resource{
+		{ARN: "arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", Tags: map[string]string{"crossplane-name": "a", "env": "prod"}},
+		{ARN: "arn:aws:ec2:us-east-1:123456789012:security-group/sg-2", Tags: map[string]string{"crossplane-name": "b", "env": "prod"}},
+		{ARN: "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1", Tags: map[string]string{"crossplane-name": "a", "env": "dev"}},
+		{ARN: "arn:aws:s3:::some-bucket", Tags: map[string]string{"crossplane-name": "a"}},
+	}})
+	s.Require().NoError(err)
+}
+
+func (s *inventorySuite) arns(out *resourcegroupstaggingapi.GetResourcesOutput) []string {
+	var arns []string
+	for _, m := range out.ResourceTagMappingList {
+		arns = append(arns, aws.ToString(m.ResourceARN))
+	}
+	return arns
+}
+
+func (s *inventorySuite) TestGetResources_TagFilters_ShouldAllMatch() {
+	out, err := s.client.GetResources(context.Background(), &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []types.TagFilter{
+			{Key: aws.String("crossplane-name"), Values: []string{"a", "b"}},
+			{Key: aws.String("env"), Values: []string{"prod"}},
+		},
+	})
+
+	s.NoError(err)
+	s.Equal([]string{"arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", "arn:aws:ec2:us-east-1:123456789012:security-group/sg-2"}, s.arns(out))
+
+	// filters without values only need the key
+	out, err = s.client.GetResources(context.Background(), &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []types.TagFilter{{Key: aws.String("env")}, {Key: aws.String("crossplane-name"), Values: []string{"a"}}},
+	})
+
+	s.NoError(err)
+	s.Equal([]string{"arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1"}, s.arns(out))
+}
+
+func (s *inventorySuite) TestGetResources_ResourceTypeFilters_ShouldMatchAny() {
+	out, err := s.client.GetResources(context.Background(), &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters:          []types.TagFilter{{Key: aws.String("crossplane-name"), Values: []string{"a"}}},
+		ResourceTypeFilters: []string{"ec2:vpc", "s3"},
+	})
+
+	s.NoError(err)
+	s.Equal([]string{"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1", "arn:aws:s3:::some-bucket"}, s.arns(out))
+}
+
+func (s *inventorySuite) TestGetResources_ShouldPaginate() {
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(s.client, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourcesPerPage: aws.Int32(3),
+	})
+
+	var pages [][]string
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+		s.Require().NoError(err)
+		pages = append(pages, s.arns(page))
+	}
+
+	s.Len(pages, 2)
+	s.Len(pages[0], 3)
+	s.Equal([]string{"arn:aws:s3:::some-bucket"}, pages[1])
+}
+
+func (s *inventorySuite) TestGetResources_ShouldSortTagsByKey() {
+	out, err := s.client.GetResources(context.Background(), &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"ec2:vpc"},
+	})
+
+	s.NoError(err)
+	s.Equal([]types.Tag{
+		{Key: aws.String("crossplane-name"), Value: aws.String("a")},
+		{Key: aws.String("env"), Value: aws.String("dev")},
+	}, out.ResourceTagMappingList[0].Tags)
+}
+
+func (s *inventorySuite) TestLoad_ShouldReadYAMLAndJSON() {
+	dir := s.T().TempDir()
+	yamlPath := filepath.Join(dir, "inventory.yaml")
+	s.Require().NoError(os.WriteFile(yamlPath, []byte(`
+resources:
+- arn: arn:aws:ec2:us-east-1:123456789012:security-group/sg-1
+  tags:
+    crossplane-name: a
+`), 0o600))
+	jsonPath := filepath.Join(dir, "inventory.json")
+	s.Require().NoError(os.WriteFile(jsonPath, []byte(`{"resources": [{"arn": "arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", "tags": {"crossplane-name": "a"}}]}`), 0o600))
+
+	for _, path := range []string{yamlPath, jsonPath} {
+		client, err := Load(path)
+		s.Require().NoError(err)
+		s.Equal([]Resource{{ARN: "arn:aws:ec2:us-east-1:123456789012:security-group/sg-1", Tags: map[string]string{"crossplane-name": "a"}}}, client.resources)
+	}
+}
+
+func (s *inventorySuite) TestNew_InvalidInventory_ShouldFail() {
+	testCases := []struct {
+		name      string
+		resources []Resource
+	}{
+		{
+			name:      "No ARN",
+			resources: []Resource{{Tags: map[string]string{"a": "b"}}},
+		},
+		{
+			name:      "Invalid ARN",
+			resources: []Resource{{ARN: "sg-1"}},
+		},
+		{
+			name: "Duplicate ARN",
+			resources: []Resource{
+				{ARN: "arn:aws:s3:::some-bucket"},
+				{ARN: "arn:aws:s3:::some-bucket"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := New(Inventory{Resources: tc.resources})
+			s.Error(err)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package internal
+
+import "regexp"
+
+// kindMetadata is what the function knows about a managed resource kind. The registry of provider-upjet-aws kinds is
+// generated from the provider CRDs and hack/kind_metadata.yaml, see zz_kinds.go. The one of the classic
+// crossplane-contrib provider-aws is maintained by hand, see kinds_classic.go.
+type kindMetadata struct {
+	// tagsField is the field holding the kind's tags in .spec.forProvider and .spec.initProvider, empty if the kind
+	// doesn't support tags
+	tagsField string
+	tagsShape tagShape
+	// tagEntryKey and tagEntryValue name the fields of list-shaped tag entries, "key" and "value" if empty
+	tagEntryKey, tagEntryValue string
+	// tagEntryDefaults are additional fields set on entries added to list-shaped tags
+	tagEntryDefaults map[string]any
+	// global kinds, like IAM roles, have no region
+	global bool
+	// resourceType is the kind's Resource Groups Tagging API resource type, as in ResourceTypeFilters (eg, "ec2:security-group")
+	resourceType string
+	// cloudFormationType is the CloudFormation type the Cloud Control API lists the kind with (eg, "AWS::EC2::SecurityGroup"),
+	// empty if it's unknown or if the kind's CloudFormation primary identifier isn't its external name
+	cloudFormationType string
+	// awsConfigType is the kind's AWS Config resource type (eg, "AWS::IAM::Policy"), empty if it's unknown
+	awsConfigType string
+	// externalNameFromARN extracts the kind's external name from an ARN in its "name" group, nil if that's not possible
+	externalNameFromARN *regexp.Regexp
+}
+
+// kindFor returns the metadata of the given lower-case group-kind, if known
+func kindFor(groupKind string) (kindMetadata, bool) {
+	if md, ok := kindRegistry[groupKind]; ok {
+		return md, true
+	}
+	md, ok := classicKindRegistry[groupKind]
+	return md, ok
+}
@@ -0,0 +1,74 @@
+package internal
+
+import "regexp"
+
+// classicKindRegistry holds kinds of the classic crossplane-contrib provider-aws (*.aws.crossplane.io) the function
+// can import. Unlike provider-upjet-aws, its kinds don't share a tags format: most model tags as a list of key-value
+// objects in .spec.forProvider.tags, some as a map, and ACK-generated ones name the entry fields "tagKey" and "tagValue".
+// It searches AWS with the same "crossplane-kind" and "crossplane-name" tags, though.
+var classicKindRegistry = map[string]kindMetadata{
+	"address.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:elastic-ip",
+		externalNameFromARN: regexp.MustCompile(`:elastic-ip/(?P<name>[^/]+)$`),
+	},
+	"internetgateway.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:internet-gateway",
+		externalNameFromARN: regexp.MustCompile(`:internet-gateway/(?P<name>[^/]+)$`),
+	},
+	"natgateway.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:natgateway",
+		externalNameFromARN: regexp.MustCompile(`:natgateway/(?P<name>[^/]+)$`),
+	},
+	"routetable.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:route-table",
+		externalNameFromARN: regexp.MustCompile(`:route-table/(?P<name>[^/]+)$`),
+	},
+	"securitygroup.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:security-group",
+		externalNameFromARN: regexp.MustCompile(`:security-group/(?P<name>[^/]+)$`),
+	},
+	"subnet.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:subnet",
+		externalNameFromARN: regexp.MustCompile(`:subnet/(?P<name>[^/]+)$`),
+	},
+	"vpc.ec2.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ec2:vpc",
+		externalNameFromARN: regexp.MustCompile(`:vpc/(?P<name>[^/]+)$`),
+	},
+	"rdsinstance.database.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "rds:db",
+		externalNameFromARN: regexp.MustCompile(`:db:(?P<name>[^:]+)$`),
+	},
+	"repository.ecr.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, resourceType: "ecr:repository",
+		externalNameFromARN: regexp.MustCompile(`:repository/(?P<name>.+)$`),
+	},
+	"cluster.eks.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeMap, resourceType: "eks:cluster",
+		externalNameFromARN: regexp.MustCompile(`:cluster/(?P<name>[^/]+)$`),
+	},
+	"policy.iam.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, global: true, resourceType: "iam:policy",
+		externalNameFromARN: regexp.MustCompile(`^(?P<name>arn:.+)$`),
+	},
+	"role.iam.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, global: true, resourceType: "iam:role",
+		externalNameFromARN: regexp.MustCompile(`:role/(.+/)?(?P<name>[^/]+)$`),
+	},
+	"user.iam.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, global: true, resourceType: "iam:user",
+		externalNameFromARN: regexp.MustCompile(`:user/(.+/)?(?P<name>[^/]+)$`),
+	},
+	"key.kms.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeList, tagEntryKey: "tagKey", tagEntryValue: "tagValue", resourceType: "kms:key",
+		externalNameFromARN: regexp.MustCompile(`:key/(?P<name>[^/]+)$`),
+	},
+	"queue.sqs.aws.crossplane.io": {
+		tagsField: "tags", tagsShape: tagShapeMap, resourceType: "sqs",
+	},
+	// hosted zones can't be tagged through this provider, so they're only found by natural key
+	"hostedzone.route53.aws.crossplane.io": {
+		global: true, resourceType: "route53:hostedzone",
+		externalNameFromARN: regexp.MustCompile(`:hostedzone/(?P<name>[^/]+)$`),
+	},
+}
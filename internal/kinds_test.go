@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestKindsSuite(t *testing.T) {
+	suite.Run(t, &kindsSuite{})
+}
+
+type kindsSuite struct {
+	suite.Suite
+}
+
+func (s *kindsSuite) TestRegistry_ExternalNamePatternsShouldHaveNameGroup() {
+	for gk, md := range kindRegistry {
+		if md.externalNameFromARN != nil {
+			s.GreaterOrEqualf(md.externalNameFromARN.SubexpIndex("name"), 1, "kind: %s", gk)
+		}
+	}
+}
+
+func (s *kindsSuite) TestResource_ExternalNameFromARN() {
+	testCases := map[string]struct {
+		gvk      schema.GroupVersionKind
+		arn      string
+		wantName string
+		wantOK   bool
+	}{
+		"SecurityGroup": {
+			gvk:      schema.GroupVersionKind{Group: "ec2.aws.upbound.io", Version: "v1beta1", Kind: "SecurityGroup"},
+			arn:      "arn:aws:ec2:us-east-1:123456789012:security-group/sg-0ea154g1e2fd170bc",
+			wantName: "sg-0ea154g1e2fd170bc",
+			wantOK:   true,
+		},
+		"RoleWithPath": {
+			gvk:      schema.GroupVersionKind{Group: "iam.aws.upbound.io", Version: "v1beta1", Kind: "Role"},
+			arn:      "arn:aws:iam::123456789012:role/service-role/my-role",
+			wantName: "my-role",
+			wantOK:   true,
+		},
+		"PolicyUsesTheWholeARN": {
+			gvk:      schema.GroupVersionKind{Group: "iam.aws.upbound.io", Version: "v1beta1", Kind: "Policy"},
+			arn:      "arn:aws:iam::123456789012:policy/my-policy",
+			wantName: "arn:aws:iam::123456789012:policy/my-policy",
+			wantOK:   true,
+		},
+		"LogGroupWithWildcardSuffix": {
+			gvk:      schema.GroupVersionKind{Group: "cloudwatchlogs.aws.upbound.io", Version: "v1beta1", Kind: "Group"},
+			arn:      "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/foo:*",
+			wantName: "/aws/lambda/foo",
+			wantOK:   true,
+		},
+		"ARNOfAnotherKind": {
+			gvk: schema.GroupVersionKind{Group: "ec2.aws.upbound.io", Version: "v1beta1", Kind: "SecurityGroup"},
+			arn: "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-123",
+		},
+		"KindWithoutPattern": {
+			gvk: schema.GroupVersionKind{Group: "rds.aws.upbound.io", Version: "v1beta1", Kind: "Instance"},
+			arn: "arn:aws:rds:us-east-1:123456789012:db:my-db",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			name, ok := Resource{gvk: tc.gvk}.ExternalNameFromARN(tc.arn)
+			s.Equal(tc.wantOK, ok)
+			s.Equal(tc.wantName, name)
+		})
+	}
+}
+
+func (s *kindsSuite) TestResource_Metadata() {
+	sg := Resource{gvk: schema.GroupVersionKind{Group: "ec2.aws.upbound.io", Version: "v1beta1", Kind: "SecurityGroup"}}
+	s.Equal("ec2:security-group", sg.AWSResourceType())
+	s.Equal("AWS::EC2::SecurityGroup", sg.CloudFormationType())
+	s.Equal("AWS::EC2::SecurityGroup", sg.AWSConfigType())
+	s.False(sg.IsGlobal())
+
+	role := Resource{gvk: schema.GroupVersionKind{Group: "iam.aws.upbound.io", Version: "v1beta1", Kind: "Role"}}
+	s.Equal("iam:role", role.AWSResourceType())
+	s.True(role.IsGlobal())
+
+	policy := Resource{gvk: schema.GroupVersionKind{Group: "iam.aws.upbound.io", Version: "v1beta1", Kind: "Policy"}}
+	s.Equal("AWS::IAM::ManagedPolicy", policy.CloudFormationType())
+	s.Equal("AWS::IAM::Policy", policy.AWSConfigType())
+
+	logGroup := Resource{gvk: schema.GroupVersionKind{Group: "cloudwatchlogs.aws.upbound.io", Version: "v1beta1", Kind: "Group"}}
+	s.Equal("AWS::Logs::LogGroup", logGroup.CloudFormationType())
+
+	classic := Resource{gvk: schema.GroupVersionKind{Group: "ec2.aws.crossplane.io", Version: "v1beta1", Kind: "SecurityGroup"}}
+	s.Empty(classic.CloudFormationType())
+
+	unknown := Resource{gvk: schema.GroupVersionKind{Group: "foo.aws.upbound.io", Version: "v1beta1", Kind: "Bar"}}
+	s.Empty(unknown.AWSResourceType())
+	s.Empty(unknown.CloudFormationType())
+	s.False(unknown.IsGlobal())
+}
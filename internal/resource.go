@@ -3,8 +3,10 @@ package internal
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	fnv1 "github.com/crossplane/function-sdk-go/proto/v1"
@@ -14,18 +16,47 @@ import (
 )
 
 const (
+	// AnnotationKeySkipImport opts a desired composed resource out of the function when set to "true"
+	AnnotationKeySkipImport = "function-aws-importer.gympass.com/skip-import"
+	// AnnotationKeyPendingApproval marks composed resources imported with the "Observe" management policy only, which
+	// still need approval for full management
+	AnnotationKeyPendingApproval = "function-aws-importer.gympass.com/pending-approval"
+	// AnnotationKeyForcedOrphan marks composed resources whose "Orphan" deletion policy was forced when importing them,
+	// so it's kept on following reconciles
+	AnnotationKeyForcedOrphan = "function-aws-importer.gympass.com/forced-orphan"
+
 	externalNameAnnotationPath = `metadata.annotations["` + meta.AnnotationKeyExternalName + `"]`
-	regexpUpboundAWSGroup      = `^.+.aws.upbound.io/.+$`
+	regexpUpboundAWSGroup      = `^.+\.aws(\.m)?\.upbound\.io/.+$`
+	// regexpClassicAWSGroup matches the classic crossplane-contrib provider-aws
+	regexpClassicAWSGroup = `^.+\.aws\.crossplane\.io/.+$`
 )
 
 // Resources aggregates desired and observed managed resources from a request
 type Resources struct {
 	desiredComposed  map[string]Resource
 	observedComposed map[string]Resource
+	// skipped holds the composition names of AWS managed resources that are not managed by the function
+	skipped []string
+	// tagsOverrides take precedence over the kinds registry when deciding whether and how a kind supports tags
+	tagsOverrides TagsOverrides
 }
 
-// NewResources creates Resources based on req
-func NewResources(req *fnv1.RunFunctionRequest) (Resources, error) {
+// ResourceFilter decides whether the function should manage a desired composed resource
+type ResourceFilter func(desiredComposed Resource) bool
+
+// ResourcesOption configures Resources
+type ResourcesOption func(*Resources)
+
+// WithTagsOverrides overrides whether and how kinds support tags, instead of relying on the built-in kinds registry only
+func WithTagsOverrides(overrides TagsOverrides) ResourcesOption {
+	return func(r *Resources) {
+		r.tagsOverrides = overrides
+	}
+}
+
+// NewResources creates Resources based on req. Desired composed resources are only considered if filter returns true
+// for them and they're not annotated with AnnotationKeySkipImport. A nil filter considers all of them.
+func NewResources(req *fnv1.RunFunctionRequest, filter ResourceFilter, opts ...ResourcesOption) (Resources, error) {
 	desiredComposed, err := request.GetDesiredComposedResources(req)
 	if err != nil {
 		return Resources{}, fmt.Errorf("extracting desired composed resources from request: %v", err)
@@ -39,14 +70,26 @@ func NewResources(req *fnv1.RunFunctionRequest) (Resources, error) {
 		desiredComposed:  make(map[string]Resource, len(desiredComposed)),
 		observedComposed: make(map[string]Resource, len(observedComposed)),
 	}
+	for _, o := range opts {
+		o(&resources)
+	}
 
 	for name, desired := range desiredComposed {
-		if isAWSManagedResource(desired.Resource.GetAPIVersion()) {
-			resources.desiredComposed[string(name)] = newResourceFromDesired(name, desired)
+		if !IsAWSManagedResource(desired.Resource.GetAPIVersion()) {
+			continue
+		}
+
+		res := newResourceFromDesired(name, desired)
+		if res.skipsImport() || (filter != nil && !filter(res)) {
+			resources.skipped = append(resources.skipped, string(name))
+			continue
 		}
+		resources.desiredComposed[string(name)] = res
 	}
+	slices.Sort(resources.skipped)
+
 	for name, obs := range observedComposed {
-		if isAWSManagedResource(obs.Resource.GetAPIVersion()) {
+		if IsAWSManagedResource(obs.Resource.GetAPIVersion()) && !slices.Contains(resources.skipped, string(name)) {
 			res, err := newResourceFromObserved(name, obs)
 			if err != nil {
 				return Resources{}, fmt.Errorf("interpreting %q observed resource: %v", name, err)
@@ -58,9 +101,14 @@ func NewResources(req *fnv1.RunFunctionRequest) (Resources, error) {
 	return resources, nil
 }
 
-func isAWSManagedResource(apiVersion string) bool {
-	match, _ := regexp.Match(regexpUpboundAWSGroup, []byte(apiVersion))
-	return match
+// IsAWSManagedResource returns true if apiVersion belongs to an AWS managed resource the function supports
+func IsAWSManagedResource(apiVersion string) bool {
+	for _, re := range []string{regexpUpboundAWSGroup, regexpClassicAWSGroup} {
+		if match, _ := regexp.MatchString(re, apiVersion); match {
+			return true
+		}
+	}
+	return false
 }
 
 // AllHaveExternalNamesSet returns true if all observed composed resources have the external-name annotation set.
@@ -82,6 +130,12 @@ func (r Resources) ObservedExternalNames() map[string]string {
 	return names
 }
 
+// SkippedCompositionNames returns the sorted names, as defined in the composition, of AWS managed resources that are
+// not managed by the function
+func (r Resources) SkippedCompositionNames() []string {
+	return r.skipped
+}
+
 // LenDesired returns how many desired composed resources there are
 func (r Resources) LenDesired() int {
 	return len(r.desiredComposed)
@@ -112,8 +166,10 @@ func (r Resources) DesiredResourcesCompositionNames() []string {
 	return names
 }
 
-// SetDesiredExternalName sets the external name annotation to the desired composed resource matching the given composedName argument
-func (r Resources) SetDesiredExternalName(composedName string, name string) error {
+// SetDesiredExternalName sets the external name annotation to the desired composed resource matching the given composedName argument.
+// If the resource supports tags, it also sets the externalNameTag tag, so it's kept on the external resource from the
+// first reconcile.
+func (r Resources) SetDesiredExternalName(composedName string, name string, externalNameTag string) error {
 	if name == "" {
 		return nil
 	}
@@ -129,11 +185,91 @@ func (r Resources) SetDesiredExternalName(composedName string, name string) erro
 		return fmt.Errorf("setting external name annotation on desired: %q: %v", composedName, err)
 	}
 
+	if field, ok := r.tagFieldFor(res.GroupKind()); ok {
+		// the tag is already on the external resource, since its value was fetched from AWS, so whether the provider
+		// would apply it doesn't matter here
+		if _, err := res.setExternalNameTagOnDesired(field, externalNameTag, name); err != nil {
+			return fmt.Errorf("setting external name tag on desired: %q: %v", composedName, err)
+		}
+	}
+
 	r.desiredComposed[composedName] = res
 
 	return nil
 }
 
+// SetDesiredDeletionPolicy sets the deletion policy on the desired composed resource matching the given composedName argument
+func (r Resources) SetDesiredDeletionPolicy(composedName string, policy xpv1.DeletionPolicy) error {
+	res, ok := r.desiredComposed[composedName]
+	if !ok {
+		return fmt.Errorf("composed name %q not found", composedName)
+	}
+
+	if err := res.desiredComposed.Resource.SetString("spec.deletionPolicy", string(policy)); err != nil {
+		return fmt.Errorf("setting .spec.deletionPolicy on %s: %v", composedName, err)
+	}
+	return nil
+}
+
+// ForceDesiredOrphan sets the "Orphan" deletion policy on the desired composed resource matching the given composedName
+// argument, annotating it so the policy can be kept on following reconciles
+func (r Resources) ForceDesiredOrphan(composedName string) error {
+	if err := r.SetDesiredDeletionPolicy(composedName, xpv1.DeletionOrphan); err != nil {
+		return err
+	}
+	meta.AddAnnotations(r.desiredComposed[composedName].desiredComposed.Resource, map[string]string{AnnotationKeyForcedOrphan: "true"})
+	return nil
+}
+
+// ForcedOrphanCompositionNames returns the sorted composition names of observed composed resources whose "Orphan"
+// deletion policy was forced when importing them, which still have a desired counterpart
+func (r Resources) ForcedOrphanCompositionNames() []string {
+	var names []string
+	for name, obs := range r.observedComposed {
+		if _, ok := r.desiredComposed[name]; ok && obs.forcedOrphan {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// IsObserved returns true if there's an observed composed resource matching the given composedName argument
+func (r Resources) IsObserved(composedName string) bool {
+	_, ok := r.observedComposed[composedName]
+	return ok
+}
+
+// PendingApprovalCompositionNames returns the sorted composition names of observed composed resources imported with
+// the "Observe" management policy only, which still have a desired counterpart
+func (r Resources) PendingApprovalCompositionNames() []string {
+	var names []string
+	for name, obs := range r.observedComposed {
+		if _, ok := r.desiredComposed[name]; ok && obs.pendingApproval {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// SetDesiredObserveOnly sets the "Observe" management policy on the desired composed resource matching the given
+// composedName argument, annotating it as pending approval for full management
+func (r Resources) SetDesiredObserveOnly(composedName string) error {
+	res, ok := r.desiredComposed[composedName]
+	if !ok {
+		return fmt.Errorf("composed name %q not found", composedName)
+	}
+
+	err := res.desiredComposed.Resource.SetValue("spec.managementPolicies", []any{string(xpv1.ManagementActionObserve)})
+	if err != nil {
+		return fmt.Errorf("setting .spec.managementPolicies on %s: %v", composedName, err)
+	}
+
+	meta.AddAnnotations(res.desiredComposed.Resource, map[string]string{AnnotationKeyPendingApproval: "true"})
+	return nil
+}
+
 // FoundExistingResources detects whether existing external resources have been found so far based on the existence of
 // an external name in each of them. Returns true if at least one desired composed resource has its external name set
 func (r Resources) FoundExistingResources() bool {
@@ -163,11 +299,45 @@ func (r Resources) DesiredExternalNames() map[string]string {
 	return names
 }
 
+// EnsureExternalNameAnnotations copies over the external-name annotation of observed resources to desired resources that
+// don't set it, so it isn't dropped on the reconciles following the import, when previous steps don't set it
+func (r Resources) EnsureExternalNameAnnotations() error {
+	for k, obs := range r.observedComposed {
+		if len(obs.externalName) == 0 {
+			continue
+		}
+		des, ok := r.desiredComposed[k]
+		if !ok {
+			continue
+		}
+
+		desired, err := des.desiredComposed.Resource.GetString(externalNameAnnotationPath)
+		if ignoreNotFound(err) != nil {
+			return fmt.Errorf("getting %q value of %s: %v", externalNameAnnotationPath, k, err)
+		}
+		if len(desired) > 0 {
+			continue
+		}
+
+		if err := des.setExternalNameAnnotationOnDesired(obs.externalName); err != nil {
+			return fmt.Errorf("setting external name annotation on desired: %q: %v", k, err)
+		}
+	}
+	return nil
+}
+
 // EnsureExternalNameTags copies over values from the external-name annotation in observed resources to desired resources'
-// .spec.forProvider.tag if the desired resource supports it.
-func (r Resources) EnsureExternalNameTags(externalNameTag string) error {
+// tags if the desired resource supports it. Tags are written to .spec.forProvider.tags, unless the desired resource
+// defines its tags in .spec.initProvider.tags only. It returns the composition names of resources the provider is not
+// guaranteed to apply the tag to, because of their management policies or because their tags are in .spec.initProvider.
+func (r Resources) EnsureExternalNameTags(externalNameTag string) ([]string, error) {
+	var unguaranteed []string
 	for k, obs := range r.observedComposed {
-		if len(obs.externalName) == 0 || !taggableGroupKinds[obs.GroupKind()] {
+		if len(obs.externalName) == 0 {
+			continue
+		}
+		field, taggable := r.tagFieldFor(obs.GroupKind())
+		if !taggable {
 			continue
 		}
 
@@ -176,13 +346,17 @@ func (r Resources) EnsureExternalNameTags(externalNameTag string) error {
 			continue
 		}
 
-		err := des.desiredComposed.Resource.SetString("spec.forProvider.tags."+externalNameTag, obs.externalName)
+		guaranteed, err := des.setExternalNameTagOnDesired(field, externalNameTag, obs.externalName)
 		if err != nil {
-			return fmt.Errorf("setting .spec.forProvider.tags on %s: %v", des.compositionName, err)
+			return nil, err
+		}
+		if !guaranteed {
+			unguaranteed = append(unguaranteed, k)
 		}
 	}
 
-	return nil
+	slices.Sort(unguaranteed)
+	return unguaranteed, nil
 }
 
 // Resource represents a single managed resource, be it observed or desired
@@ -191,8 +365,13 @@ type Resource struct {
 	// Improve cohesion, maybe split into separate 'basic' resource that's composed between both types.
 	k8sName         string
 	gvk             schema.GroupVersionKind
+	labels          map[string]string
 	compositionName string
 	externalName    string
+	// pendingApproval is only used for observed, see AnnotationKeyPendingApproval
+	pendingApproval bool
+	// forcedOrphan is only used for observed, see AnnotationKeyForcedOrphan
+	forcedOrphan    bool
 	desiredComposed *resource.DesiredComposed
 }
 
@@ -200,6 +379,7 @@ func newResourceFromDesired(compositionName resource.Name, composed *resource.De
 	return Resource{
 		k8sName:         composed.Resource.GetName(),
 		gvk:             composed.Resource.GroupVersionKind(),
+		labels:          composed.Resource.GetLabels(),
 		compositionName: string(compositionName),
 		desiredComposed: composed,
 	}
@@ -209,6 +389,7 @@ func newResourceFromObserved(compositionName resource.Name, composed resource.Ob
 	res := Resource{
 		k8sName:         composed.Resource.GetName(),
 		gvk:             composed.Resource.GroupVersionKind(),
+		labels:          composed.Resource.GetLabels(),
 		compositionName: string(compositionName),
 	}
 
@@ -218,6 +399,8 @@ func newResourceFromObserved(compositionName resource.Name, composed resource.Ob
 	}
 
 	res.externalName = extName
+	res.pendingApproval = composed.Resource.GetAnnotations()[AnnotationKeyPendingApproval] == "true"
+	res.forcedOrphan = composed.Resource.GetAnnotations()[AnnotationKeyForcedOrphan] == "true"
 	return res, nil
 }
 
@@ -231,11 +414,161 @@ func (r Resource) GroupKind() string {
 	return strings.ToLower(r.gvk.GroupKind().String())
 }
 
+// Labels returns the composed resource's labels
+func (r Resource) Labels() map[string]string {
+	return r.labels
+}
+
 // CompositionName returns the composed resource's name as defined in the composition
 func (r Resource) CompositionName() string {
 	return r.compositionName
 }
 
+// AWSResourceType returns the composed resource's Resource Groups Tagging API resource type (eg, "ec2:security-group"),
+// or an empty string if it's unknown
+func (r Resource) AWSResourceType() string {
+	md, _ := kindFor(r.GroupKind())
+	return md.resourceType
+}
+
+// CloudFormationType returns the composed resource's CloudFormation type (eg, "AWS::EC2::SecurityGroup"), or an empty
+// string if it's unknown
+func (r Resource) CloudFormationType() string {
+	md, _ := kindFor(r.GroupKind())
+	return md.cloudFormationType
+}
+
+// AWSConfigType returns the composed resource's AWS Config resource type (eg, "AWS::IAM::Policy"), or an empty string
+// if it's unknown
+func (r Resource) AWSConfigType() string {
+	md, _ := kindFor(r.GroupKind())
+	return md.awsConfigType
+}
+
+// IsGlobal returns true if the composed resource's kind has no region, like IAM roles
+func (r Resource) IsGlobal() bool {
+	md, _ := kindFor(r.GroupKind())
+	return md.global
+}
+
+// ExternalNameFromARN extracts the composed resource's external name from the given ARN of its external resource.
+// It returns false if the kind's external name can't be derived from ARNs or if arn doesn't match the kind.
+func (r Resource) ExternalNameFromARN(arn string) (string, bool) {
+	md, _ := kindFor(r.GroupKind())
+	if md.externalNameFromARN == nil {
+		return "", false
+	}
+
+	match := md.externalNameFromARN.FindStringSubmatch(arn)
+	if match == nil {
+		return "", false
+	}
+	name := match[md.externalNameFromARN.SubexpIndex("name")]
+	return name, name != ""
+}
+
+// DesiredString returns the string at path of the desired composed resource (eg, "spec.forProvider.region"), and
+// whether it's set and not empty
+func (r Resource) DesiredString(path string) (string, bool, error) {
+	v, err := r.desiredComposed.Resource.GetString(path)
+	if fieldpath.IsNotFound(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("getting .%s: %v", path, err)
+	}
+	return v, len(v) > 0, nil
+}
+
+// HasDesiredField returns true if the desired composed resource has the field at path set, to any value
+func (r Resource) HasDesiredField(path string) (bool, error) {
+	_, err := r.desiredComposed.Resource.GetValue(path)
+	if fieldpath.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting .%s: %v", path, err)
+	}
+	return true, nil
+}
+
+// DeletesExternalResource returns true if deleting the desired composed resource would also delete its external
+// resource. That's the case when both its deletion policy and management policies allow deletion, which they do by default.
+func (r Resource) DeletesExternalResource() (bool, error) {
+	deletionPolicy, err := r.desiredComposed.Resource.GetString("spec.deletionPolicy")
+	if ignoreNotFound(err) != nil {
+		return false, fmt.Errorf("getting .spec.deletionPolicy: %v", err)
+	}
+	if deletionPolicy == string(xpv1.DeletionOrphan) {
+		return false, nil
+	}
+
+	managementPolicies, err := r.desiredComposed.Resource.GetStringArray("spec.managementPolicies")
+	if fieldpath.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting .spec.managementPolicies: %v", err)
+	}
+	return slices.Contains(managementPolicies, string(xpv1.ManagementActionAll)) ||
+		slices.Contains(managementPolicies, string(xpv1.ManagementActionDelete)), nil
+}
+
+// setExternalNameTagOnDesired writes the external-name tag to the tags field the provider applies, returning whether the
+// provider is guaranteed to apply it to the existing external resource
+func (r Resource) setExternalNameTagOnDesired(field tagField, externalNameTag, externalName string) (bool, error) {
+	prefix, guaranteed, err := r.tagsFieldPrefix(field)
+	if err != nil {
+		return false, fmt.Errorf("deciding tags field of %s: %v", r.compositionName, err)
+	}
+
+	current, ok, err := field.getTag(r.desiredComposed.Resource, prefix, externalNameTag)
+	if err != nil {
+		return false, fmt.Errorf("getting .%s.%s of %s: %v", prefix, field.name, r.compositionName, err)
+	}
+	if ok && current == externalName {
+		return guaranteed, nil
+	}
+
+	if err := field.setTag(r.desiredComposed.Resource, prefix, externalNameTag, externalName); err != nil {
+		return false, fmt.Errorf("setting .%s.%s on %s: %v", prefix, field.name, r.compositionName, err)
+	}
+	return guaranteed, nil
+}
+
+// tagsFieldPrefix returns whether the tags field of the desired composed resource should be written in .spec.forProvider
+// or .spec.initProvider, and whether the provider is guaranteed to apply changes to it on the existing external resource.
+//
+// Fields in .spec.initProvider are only applied when creating the external resource, and are ignored afterward. So
+// are fields in .spec.forProvider if the management policies don't allow updates.
+func (r Resource) tagsFieldPrefix(field tagField) (string, bool, error) {
+	managementPolicies, err := r.desiredComposed.Resource.GetStringArray("spec.managementPolicies")
+	if ignoreNotFound(err) != nil {
+		return "", false, fmt.Errorf("getting .spec.managementPolicies: %v", err)
+	}
+	canUpdate := len(managementPolicies) == 0 ||
+		slices.Contains(managementPolicies, string(xpv1.ManagementActionAll)) ||
+		slices.Contains(managementPolicies, string(xpv1.ManagementActionUpdate))
+
+	hasForProviderTags, err := r.HasDesiredField("spec.forProvider." + field.name)
+	if err != nil {
+		return "", false, err
+	}
+	hasInitProviderTags, err := r.HasDesiredField("spec.initProvider." + field.name)
+	if err != nil {
+		return "", false, err
+	}
+
+	if hasInitProviderTags && !hasForProviderTags {
+		return "spec.initProvider", false, nil
+	}
+	return "spec.forProvider", canUpdate, nil
+}
+
+func (r Resource) skipsImport() bool {
+	return r.desiredComposed.Resource.GetAnnotations()[AnnotationKeySkipImport] == "true"
+}
+
 func (r Resource) setExternalNameAnnotationOnDesired(name string) error {
 	return r.desiredComposed.Resource.SetString(externalNameAnnotationPath, name)
 }
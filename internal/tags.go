@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/crossplane/function-sdk-go/resource/composed"
+)
+
+// tagShape is how a kind models its tags in .spec.forProvider and .spec.initProvider
+type tagShape string
+
+const (
+	// tagShapeMap is a map of tag keys to values, eg: tags: {foo: bar}
+	tagShapeMap tagShape = "map"
+	// tagShapeList is a list of objects with key and value fields, eg: tag: [{key: foo, value: bar}]
+	tagShapeList tagShape = "list"
+)
+
+// tagField describes the field a kind uses for its own tags
+type tagField struct {
+	// name of the field within .spec.forProvider and .spec.initProvider
+	name  string
+	shape tagShape
+	// listEntryDefaults are additional fields set on entries added to list-shaped tags
+	listEntryDefaults map[string]any
+	// listEntryKey and listEntryValue name the fields of list-shaped tag entries, "key" and "value" if empty
+	listEntryKey, listEntryValue string
+}
+
+// tagFieldFor returns the tag field of the given lower-case group-kind, if it supports tags
+func tagFieldFor(groupKind string) (tagField, bool) {
+	md, ok := kindFor(groupKind)
+	if !ok || md.tagsField == "" {
+		return tagField{}, false
+	}
+	return tagField{
+		name:              md.tagsField,
+		shape:             md.tagsShape,
+		listEntryDefaults: md.tagEntryDefaults,
+		listEntryKey:      md.tagEntryKey,
+		listEntryValue:    md.tagEntryValue,
+	}, true
+}
+
+// TagsOverride overrides whether and how a kind supports tags
+type TagsOverride struct {
+	// Field holding the kind's tags in .spec.forProvider and .spec.initProvider. Empty if the kind doesn't support tags.
+	Field string
+	// List is true if the kind's tags are a list of objects with key and value fields, instead of a map
+	List bool
+	// EntryKey and EntryValue name the fields of list entries, "key" and "value" if empty
+	EntryKey, EntryValue string
+}
+
+// TagsOverrides are indexed by lower-case group-kind
+type TagsOverrides map[string]TagsOverride
+
+// tagFieldFor returns the tag field of the given lower-case group-kind, if it supports tags, taking overrides into account
+func (r Resources) tagFieldFor(groupKind string) (tagField, bool) {
+	o, ok := r.tagsOverrides[groupKind]
+	if !ok {
+		return tagFieldFor(groupKind)
+	}
+	if o.Field == "" {
+		return tagField{}, false
+	}
+
+	field := tagField{name: o.Field, shape: tagShapeMap}
+	if o.List {
+		field.shape = tagShapeList
+		md, _ := kindFor(groupKind)
+		field.listEntryDefaults = md.tagEntryDefaults
+		field.listEntryKey, field.listEntryValue = o.EntryKey, o.EntryValue
+	}
+	return field, true
+}
+
+// setTag sets key to value in the tag field under prefix (eg, "spec.forProvider"), keeping other tags as they are
+func (f tagField) setTag(u *composed.Unstructured, prefix, key, value string) error {
+	path := prefix + "." + f.name
+	if f.shape == tagShapeMap {
+		return u.SetString(path+"."+key, value)
+	}
+
+	entries, err := f.listEntries(u, path)
+	if err != nil {
+		return err
+	}
+
+	keyField, valueField := f.entryFields()
+	for _, e := range entries {
+		if entry, ok := e.(map[string]any); ok && entry[keyField] == key {
+			entry[valueField] = value
+			return u.SetValue(path, entries)
+		}
+	}
+
+	entry := map[string]any{keyField: key, valueField: value}
+	for k, v := range f.listEntryDefaults {
+		entry[k] = v
+	}
+	return u.SetValue(path, append(entries, entry))
+}
+
+// getTag returns the value of key in the tag field under prefix (eg, "spec.forProvider"), if present
+func (f tagField) getTag(u *composed.Unstructured, prefix, key string) (string, bool, error) {
+	path := prefix + "." + f.name
+	if f.shape == tagShapeMap {
+		v, err := u.GetString(path + "." + key)
+		if ignoreNotFound(err) != nil {
+			return "", false, err
+		}
+		return v, err == nil, nil
+	}
+
+	entries, err := f.listEntries(u, path)
+	if err != nil {
+		return "", false, err
+	}
+	keyField, valueField := f.entryFields()
+	for _, e := range entries {
+		if entry, ok := e.(map[string]any); ok && entry[keyField] == key {
+			v, _ := entry[valueField].(string)
+			return v, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// entryFields returns the names of the key and value fields of list-shaped tag entries
+func (f tagField) entryFields() (string, string) {
+	keyField, valueField := f.listEntryKey, f.listEntryValue
+	if keyField == "" {
+		keyField = "key"
+	}
+	if valueField == "" {
+		valueField = "value"
+	}
+	return keyField, valueField
+}
+
+func (f tagField) listEntries(u *composed.Unstructured, path string) ([]any, error) {
+	v, err := u.GetValue(path)
+	if ignoreNotFound(err) != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	entries, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf(".%s is not a list: %T", path, v)
+	}
+	return entries, nil
+}
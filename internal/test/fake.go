@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
@@ -13,16 +14,23 @@ var _ resourcegroupstaggingapi.GetResourcesAPIClient = &FakeGetResourcesAPIClien
 
 type FakeGetResourcesAPIClient struct {
 	Resources []types.ResourceTagMapping
+	// Inputs records the input of every call to GetResources, in order
+	Inputs []*resourcegroupstaggingapi.GetResourcesInput
 }
 
 func (f *FakeGetResourcesAPIClient) GetResources(ctx context.Context, input *resourcegroupstaggingapi.GetResourcesInput, opts ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	f.Inputs = append(f.Inputs, input)
 	out := &resourcegroupstaggingapi.GetResourcesOutput{}
 
 	for _, existingMapping := range f.Resources {
+		if !matchesResourceTypeFilters(aws.ToString(existingMapping.ResourceARN), input.ResourceTypeFilters) {
+			continue
+		}
 		for _, tag := range existingMapping.Tags {
 			for _, filter := range input.TagFilters {
 				if aws.ToString(tag.Key) == aws.ToString(filter.Key) {
-					if slices.Contains(filter.Values, aws.ToString(tag.Value)) {
+					// filters without values match any resource with the given key, as the real API does
+					if len(filter.Values) == 0 || slices.Contains(filter.Values, aws.ToString(tag.Value)) {
 						// we're kinda cheating by not respecting resources per page, etc
 						// but should be ok for what we need to test
 						out.ResourceTagMappingList = append(out.ResourceTagMappingList, existingMapping)
@@ -34,3 +42,30 @@ func (f *FakeGetResourcesAPIClient) GetResources(ctx context.Context, input *res
 
 	return out, nil
 }
+
+// matchesResourceTypeFilters tells whether arn is of any of the given resource types, "<service>[:<type>]". Mappings
+// without an ARN match any resource type, so tests don't need to make up ARNs when filtering by type doesn't matter.
+func matchesResourceTypeFilters(arn string, resourceTypeFilters []string) bool {
+	if len(resourceTypeFilters) == 0 || len(arn) == 0 {
+		return true
+	}
+
+	// arn:partition:service:region:account-id:resource-type/resource-id (or resource-type:resource-id, or resource-id)
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return false
+	}
+	service, resource := parts[2], parts[5]
+	resourceType, _, found := strings.Cut(resource, "/")
+	if !found {
+		resourceType, _, _ = strings.Cut(resource, ":")
+	}
+
+	for _, filter := range resourceTypeFilters {
+		filterService, filterType, hasType := strings.Cut(filter, ":")
+		if filterService == service && (!hasType || filterType == resourceType) {
+			return true
+		}
+	}
+	return false
+}
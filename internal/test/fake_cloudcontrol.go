@@ -0,0 +1,41 @@
+package test
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+)
+
+var _ cloudcontrol.ListResourcesAPIClient = &FakeCloudControlAPIClient{}
+
+type FakeCloudControlAPIClient struct {
+	// Resources maps CloudFormation type names to their resources
+	Resources map[string][]types.ResourceDescription
+	// PageSize limits how many resources are returned per call, all of them if zero
+	PageSize int
+	// Inputs records the input of every call to ListResources, in order
+	Inputs []*cloudcontrol.ListResourcesInput
+}
+
+func (f *FakeCloudControlAPIClient) ListResources(ctx context.Context, input *cloudcontrol.ListResourcesInput, opts ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+	f.Inputs = append(f.Inputs, input)
+	resources := f.Resources[aws.ToString(input.TypeName)]
+
+	start := 0
+	if token := aws.ToString(input.NextToken); len(token) > 0 {
+		var err error
+		if start, err = strconv.Atoi(token); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &cloudcontrol.ListResourcesOutput{TypeName: input.TypeName, ResourceDescriptions: resources[start:]}
+	if f.PageSize > 0 && len(resources)-start > f.PageSize {
+		out.ResourceDescriptions = resources[start : start+f.PageSize]
+		out.NextToken = aws.String(strconv.Itoa(start + f.PageSize))
+	}
+	return out, nil
+}
@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+)
+
+var _ configservice.SelectAggregateResourceConfigAPIClient = &FakeSelectAggregateResourceConfigAPIClient{}
+
+// FakeSelectAggregateResourceConfigAPIClient returns Results for any query, one page per element, as a real aggregator
+// would return rows matching the query
+type FakeSelectAggregateResourceConfigAPIClient struct {
+	Results [][]map[string]any
+	// Inputs records the input of every call to SelectAggregateResourceConfig, in order
+	Inputs []*configservice.SelectAggregateResourceConfigInput
+}
+
+func (f *FakeSelectAggregateResourceConfigAPIClient) SelectAggregateResourceConfig(ctx context.Context, input *configservice.SelectAggregateResourceConfigInput, opts ...func(*configservice.Options)) (*configservice.SelectAggregateResourceConfigOutput, error) {
+	f.Inputs = append(f.Inputs, input)
+	out := &configservice.SelectAggregateResourceConfigOutput{}
+
+	page := 0
+	if token := aws.ToString(input.NextToken); len(token) > 0 {
+		var err error
+		if page, err = strconv.Atoi(token); err != nil {
+			return nil, err
+		}
+	}
+	if page >= len(f.Results) {
+		return out, nil
+	}
+
+	for _, r := range f.Results[page] {
+		row, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		out.Results = append(out.Results, string(row))
+	}
+	if page+1 < len(f.Results) {
+		out.NextToken = aws.String(strconv.Itoa(page + 1))
+	}
+	return out, nil
+}
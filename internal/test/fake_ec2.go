@@ -0,0 +1,54 @@
+package test
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+var _ ec2.DescribeSecurityGroupsAPIClient = &FakeDescribeSecurityGroupsAPIClient{}
+
+type FakeDescribeSecurityGroupsAPIClient struct {
+	SecurityGroups []types.SecurityGroup
+	// Inputs records the input of every call to DescribeSecurityGroups, in order
+	Inputs []*ec2.DescribeSecurityGroupsInput
+	// Err is returned by every call, if set
+	Err error
+}
+
+// DescribeSecurityGroups only supports the "group-name" and "vpc-id" filters, which must all match, as in the real API
+func (f *FakeDescribeSecurityGroupsAPIClient) DescribeSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	f.Inputs = append(f.Inputs, input)
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	out := &ec2.DescribeSecurityGroupsOutput{}
+
+	for _, sg := range f.SecurityGroups {
+		if matchesSecurityGroupFilters(sg, input.Filters) {
+			out.SecurityGroups = append(out.SecurityGroups, sg)
+		}
+	}
+	return out, nil
+}
+
+func matchesSecurityGroupFilters(sg types.SecurityGroup, filters []types.Filter) bool {
+	for _, filter := range filters {
+		var value string
+		switch aws.ToString(filter.Name) {
+		case "group-name":
+			value = aws.ToString(sg.GroupName)
+		case "vpc-id":
+			value = aws.ToString(sg.VpcId)
+		default:
+			return false
+		}
+		if !slices.Contains(filter.Values, value) {
+			return false
+		}
+	}
+	return true
+}
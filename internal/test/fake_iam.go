@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// FakeIAMAPIClient gets IAM entities by name, and lists them in a single page, ignoring filters and scopes
+type FakeIAMAPIClient struct {
+	Roles            []types.Role
+	Policies         []types.Policy
+	Users            []types.User
+	InstanceProfiles []types.InstanceProfile
+	// Tags maps the names of roles, users and instance profiles, and the ARNs of policies, to their tags
+	Tags map[string][]types.Tag
+	// Calls records the operations called, like "GetRole" or "ListRoles"
+	Calls []string
+}
+
+func (f *FakeIAMAPIClient) GetRole(ctx context.Context, input *iam.GetRoleInput, opts ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	f.Calls = append(f.Calls, "GetRole")
+	for _, r := range f.Roles {
+		if aws.ToString(r.RoleName) == aws.ToString(input.RoleName) {
+			r.Tags = f.Tags[aws.ToString(r.RoleName)]
+			return &iam.GetRoleOutput{Role: &r}, nil
+		}
+	}
+	return nil, &types.NoSuchEntityException{Message: aws.String("role not found")}
+}
+
+func (f *FakeIAMAPIClient) GetUser(ctx context.Context, input *iam.GetUserInput, opts ...func(*iam.Options)) (*iam.GetUserOutput, error) {
+	f.Calls = append(f.Calls, "GetUser")
+	for _, u := range f.Users {
+		if aws.ToString(u.UserName) == aws.ToString(input.UserName) {
+			u.Tags = f.Tags[aws.ToString(u.UserName)]
+			return &iam.GetUserOutput{User: &u}, nil
+		}
+	}
+	return nil, &types.NoSuchEntityException{Message: aws.String("user not found")}
+}
+
+func (f *FakeIAMAPIClient) GetInstanceProfile(ctx context.Context, input *iam.GetInstanceProfileInput, opts ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error) {
+	f.Calls = append(f.Calls, "GetInstanceProfile")
+	for _, p := range f.InstanceProfiles {
+		if aws.ToString(p.InstanceProfileName) == aws.ToString(input.InstanceProfileName) {
+			p.Tags = f.Tags[aws.ToString(p.InstanceProfileName)]
+			return &iam.GetInstanceProfileOutput{InstanceProfile: &p}, nil
+		}
+	}
+	return nil, &types.NoSuchEntityException{Message: aws.String("instance profile not found")}
+}
+
+func (f *FakeIAMAPIClient) ListRoles(ctx context.Context, input *iam.ListRolesInput, opts ...func(*iam.Options)) (*iam.ListRolesOutput, error) {
+	f.Calls = append(f.Calls, "ListRoles")
+	return &iam.ListRolesOutput{Roles: f.Roles}, nil
+}
+
+func (f *FakeIAMAPIClient) ListRoleTags(ctx context.Context, input *iam.ListRoleTagsInput, opts ...func(*iam.Options)) (*iam.ListRoleTagsOutput, error) {
+	f.Calls = append(f.Calls, "ListRoleTags")
+	return &iam.ListRoleTagsOutput{Tags: f.Tags[aws.ToString(input.RoleName)]}, nil
+}
+
+func (f *FakeIAMAPIClient) ListPolicies(ctx context.Context, input *iam.ListPoliciesInput, opts ...func(*iam.Options)) (*iam.ListPoliciesOutput, error) {
+	f.Calls = append(f.Calls, "ListPolicies")
+	return &iam.ListPoliciesOutput{Policies: f.Policies}, nil
+}
+
+func (f *FakeIAMAPIClient) ListPolicyTags(ctx context.Context, input *iam.ListPolicyTagsInput, opts ...func(*iam.Options)) (*iam.ListPolicyTagsOutput, error) {
+	f.Calls = append(f.Calls, "ListPolicyTags")
+	return &iam.ListPolicyTagsOutput{Tags: f.Tags[aws.ToString(input.PolicyArn)]}, nil
+}
+
+func (f *FakeIAMAPIClient) ListUsers(ctx context.Context, input *iam.ListUsersInput, opts ...func(*iam.Options)) (*iam.ListUsersOutput, error) {
+	f.Calls = append(f.Calls, "ListUsers")
+	return &iam.ListUsersOutput{Users: f.Users}, nil
+}
+
+func (f *FakeIAMAPIClient) ListUserTags(ctx context.Context, input *iam.ListUserTagsInput, opts ...func(*iam.Options)) (*iam.ListUserTagsOutput, error) {
+	f.Calls = append(f.Calls, "ListUserTags")
+	return &iam.ListUserTagsOutput{Tags: f.Tags[aws.ToString(input.UserName)]}, nil
+}
+
+func (f *FakeIAMAPIClient) ListInstanceProfiles(ctx context.Context, input *iam.ListInstanceProfilesInput, opts ...func(*iam.Options)) (*iam.ListInstanceProfilesOutput, error) {
+	f.Calls = append(f.Calls, "ListInstanceProfiles")
+	return &iam.ListInstanceProfilesOutput{InstanceProfiles: f.InstanceProfiles}, nil
+}
+
+func (f *FakeIAMAPIClient) ListInstanceProfileTags(ctx context.Context, input *iam.ListInstanceProfileTagsInput, opts ...func(*iam.Options)) (*iam.ListInstanceProfileTagsOutput, error) {
+	f.Calls = append(f.Calls, "ListInstanceProfileTags")
+	return &iam.ListInstanceProfileTagsOutput{Tags: f.Tags[aws.ToString(input.InstanceProfileName)]}, nil
+}
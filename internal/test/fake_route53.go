@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+type FakeRoute53HostedZonesAPIClient struct {
+	HostedZones []types.HostedZone
+	// VPCs maps the IDs of private hosted zones to the IDs of the VPCs they're associated with
+	VPCs map[string][]string
+	// PageSize limits how many hosted zones are returned per call, all of them if zero
+	PageSize int
+	// Tags maps the IDs of hosted zones to their tags
+	Tags map[string][]types.Tag
+}
+
+// ListHostedZonesByName lists hosted zones in DNS name order starting from input.DNSName, as the real API does: by
+// labels in reverse order, case-insensitively, so "example.com." comes before "a.example.com."
+func (f *FakeRoute53HostedZonesAPIClient) ListHostedZonesByName(ctx context.Context, input *route53.ListHostedZonesByNameInput, opts ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error) {
+	zones := slices.Clone(f.HostedZones)
+	slices.SortStableFunc(zones, func(a, b types.HostedZone) int {
+		return strings.Compare(dnsNameOrder(aws.ToString(a.Name)), dnsNameOrder(aws.ToString(b.Name)))
+	})
+
+	start := slices.IndexFunc(zones, func(z types.HostedZone) bool {
+		if len(aws.ToString(input.HostedZoneId)) > 0 {
+			return aws.ToString(z.Id) == aws.ToString(input.HostedZoneId)
+		}
+		return dnsNameOrder(aws.ToString(z.Name)) >= dnsNameOrder(aws.ToString(input.DNSName))
+	})
+	if start < 0 {
+		return &route53.ListHostedZonesByNameOutput{}, nil
+	}
+	zones = zones[start:]
+
+	out := &route53.ListHostedZonesByNameOutput{HostedZones: zones}
+	if f.PageSize > 0 && len(zones) > f.PageSize {
+		out.HostedZones = zones[:f.PageSize]
+		out.IsTruncated = true
+		out.NextDNSName = zones[f.PageSize].Name
+		out.NextHostedZoneId = zones[f.PageSize].Id
+	}
+	return out, nil
+}
+
+// ListHostedZonesByVPC lists the hosted zones associated with input.VPCId in VPCs, ignoring the VPC's region
+func (f *FakeRoute53HostedZonesAPIClient) ListHostedZonesByVPC(ctx context.Context, input *route53.ListHostedZonesByVPCInput, opts ...func(*route53.Options)) (*route53.ListHostedZonesByVPCOutput, error) {
+	out := &route53.ListHostedZonesByVPCOutput{}
+	for _, z := range f.HostedZones {
+		if slices.Contains(f.VPCs[aws.ToString(z.Id)], aws.ToString(input.VPCId)) {
+			out.HostedZoneSummaries = append(out.HostedZoneSummaries, types.HostedZoneSummary{
+				HostedZoneId: z.Id,
+				Name:         z.Name,
+			})
+		}
+	}
+	return out, nil
+}
+
+// ListTagsForResources lists the tags of the hosted zones in input.ResourceIds, which don't have the "/hostedzone/"
+// prefix, failing with more than 10 of them as the real API does
+func (f *FakeRoute53HostedZonesAPIClient) ListTagsForResources(ctx context.Context, input *route53.ListTagsForResourcesInput, opts ...func(*route53.Options)) (*route53.ListTagsForResourcesOutput, error) {
+	if len(input.ResourceIds) > 10 {
+		return nil, fmt.Errorf("at most 10 resource IDs are allowed, got %d", len(input.ResourceIds))
+	}
+
+	out := &route53.ListTagsForResourcesOutput{}
+	for _, id := range input.ResourceIds {
+		out.ResourceTagSets = append(out.ResourceTagSets, types.ResourceTagSet{
+			ResourceId:   aws.String(id),
+			ResourceType: input.ResourceType,
+			Tags:         f.Tags["/hostedzone/"+id],
+		})
+	}
+	return out, nil
+}
+
+func dnsNameOrder(name string) string {
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(name, ".")), ".")
+	slices.Reverse(labels)
+	return strings.Join(labels, ".")
+}
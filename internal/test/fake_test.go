@@ -160,3 +160,93 @@ func (s *fakeGetResourcesAPIClientSuite) TestGetResources_OneFilterMatchesAllRes
 		},
 	}, gotRes.ResourceTagMappingList)
 }
+
+func (s *fakeGetResourcesAPIClientSuite) TestGetResources_FilterWithoutValues_ShouldMatchOnKeyOnly() {
+	fake := &FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{
+			{
+				ResourceARN: aws.String("some-arn"),
+				Tags: []types.Tag{{
+					Key:   aws.String("key"),
+					Value: aws.String("value"),
+				}},
+			},
+			{
+				ResourceARN: aws.String("another-arn"),
+				Tags: []types.Tag{{
+					Key:   aws.String("another-key"),
+					Value: aws.String("another-value"),
+				}},
+			},
+		},
+	}
+
+	gotRes, gotErr := fake.GetResources(context.Background(), &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []types.TagFilter{{
+			Key: aws.String("key"),
+		}},
+	})
+
+	s.Nil(gotErr)
+
+	s.Len(gotRes.ResourceTagMappingList, 1)
+	s.Equal(aws.String("some-arn"), gotRes.ResourceTagMappingList[0].ResourceARN)
+}
+
+func (s *fakeGetResourcesAPIClientSuite) TestGetResources_ResourceTypeFilters_ShouldMatchOnARN() {
+	tags := []types.Tag{{Key: aws.String("key"), Value: aws.String("value")}}
+	fake := &FakeGetResourcesAPIClient{
+		Resources: []types.ResourceTagMapping{
+			{ResourceARN: aws.String("arn:aws:ec2:us-east-1:123456789012:security-group/sg-1"), Tags: tags},
+			{ResourceARN: aws.String("arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1"), Tags: tags},
+			{ResourceARN: aws.String("arn:aws:s3:::bucket"), Tags: tags},
+			{ResourceARN: aws.String("arn:aws:logs:us-east-1:123456789012:log-group:foo"), Tags: tags},
+		},
+	}
+
+	testCases := map[string]struct {
+		resourceTypeFilters []string
+		wantARNs            []string
+	}{
+		"No filters": {
+			wantARNs: []string{
+				"arn:aws:ec2:us-east-1:123456789012:security-group/sg-1",
+				"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1",
+				"arn:aws:s3:::bucket",
+				"arn:aws:logs:us-east-1:123456789012:log-group:foo",
+			},
+		},
+		"Service and type": {
+			resourceTypeFilters: []string{"ec2:security-group"},
+			wantARNs:            []string{"arn:aws:ec2:us-east-1:123456789012:security-group/sg-1"},
+		},
+		"Service only": {
+			resourceTypeFilters: []string{"s3", "ec2"},
+			wantARNs: []string{
+				"arn:aws:ec2:us-east-1:123456789012:security-group/sg-1",
+				"arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1",
+				"arn:aws:s3:::bucket",
+			},
+		},
+		"Type separated by colon": {
+			resourceTypeFilters: []string{"logs:log-group"},
+			wantARNs:            []string{"arn:aws:logs:us-east-1:123456789012:log-group:foo"},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			got, err := fake.GetResources(context.Background(), &resourcegroupstaggingapi.GetResourcesInput{
+				TagFilters:          []types.TagFilter{{Key: aws.String("key")}},
+				ResourceTypeFilters: tc.resourceTypeFilters,
+			})
+			s.NoError(err)
+
+			var gotARNs []string
+			for _, m := range got.ResourceTagMappingList {
+				gotARNs = append(gotARNs, aws.ToString(m.ResourceARN))
+			}
+			s.Equal(tc.wantARNs, gotARNs)
+		})
+	}
+}
@@ -0,0 +1,964 @@
+// this file has been autogenerated by "go generate". Do not edit it manually
+// provider-upjet-aws version: v1.14.0
+//
+//go:generate go run ../hack/genkinds --crds=${PROVIDER_UPJET_AWS_CRDS} --provider-version-file=../hack/provider-upjet-aws.version --metadata=../hack/kind_metadata.yaml --out=zz_kinds.go
+package internal
+
+import "regexp"
+
+var kindRegistry = map[string]kindMetadata{
+	"accelerator.globalaccelerator.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"accessentry.eks.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"accesskey.iam.aws.upbound.io":                                           {global: true},
+	"accesspoint.efs.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"accesspoint.s3control.aws.upbound.io":                                   {},
+	"accesspointpolicy.s3control.aws.upbound.io":                             {},
+	"accesspolicy.opensearchserverless.aws.upbound.io":                       {},
+	"accesspolicyassociation.eks.aws.upbound.io":                             {},
+	"account.apigateway.aws.upbound.io":                                      {},
+	"account.macie2.aws.upbound.io":                                          {},
+	"account.organizations.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"account.securityhub.aws.upbound.io":                                     {},
+	"accountalias.iam.aws.upbound.io":                                        {global: true},
+	"accountassignment.ssoadmin.aws.upbound.io":                              {},
+	"accountpasswordpolicy.iam.aws.upbound.io":                               {global: true},
+	"accountpublicaccessblock.s3control.aws.upbound.io":                      {},
+	"accountsettingdefault.ecs.aws.upbound.io":                               {},
+	"acl.memorydb.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"actiontarget.securityhub.aws.upbound.io":                                {},
+	"activation.ssm.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"activereceiptruleset.ses.aws.upbound.io":                                {},
+	"activity.sfn.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"addon.eks.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"alertmanagerdefinition.amp.aws.upbound.io":                              {},
+	"alias.gamelift.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"alias.kms.aws.upbound.io":                                               {},
+	"alias.lambda.aws.upbound.io":                                            {},
+	"alternatecontact.account.aws.upbound.io":                                {},
+	"ami.ec2.aws.upbound.io":                                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"amicopy.ec2.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"amilaunchpermission.ec2.aws.upbound.io":                                 {},
+	"analyzer.accessanalyzer.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"anomalymonitor.ce.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"api.apigatewayv2.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"apicache.appsync.aws.upbound.io":                                        {},
+	"apidestination.cloudwatchevents.aws.upbound.io":                         {},
+	"apikey.apigateway.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"apikey.appsync.aws.upbound.io":                                          {},
+	"apimapping.apigatewayv2.aws.upbound.io":                                 {},
+	"app.amplify.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"app.deploy.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"app.pinpoint.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"app.sagemaker.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"appcookiestickinesspolicy.elb.aws.upbound.io":                           {},
+	"appimageconfig.sagemaker.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.appconfig.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.applicationinsights.aws.upbound.io":                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.elasticbeanstalk.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.emrserverless.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.kinesisanalytics.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.kinesisanalyticsv2.aws.upbound.io":                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"application.opsworks.aws.upbound.io":                                    {global: true},
+	"applicationsnapshot.kinesisanalyticsv2.aws.upbound.io":                  {},
+	"applicationversion.elasticbeanstalk.aws.upbound.io":                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"appmonitor.rum.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"approvalruletemplate.codecommit.aws.upbound.io":                         {},
+	"approvalruletemplateassociation.codecommit.aws.upbound.io":              {},
+	"archive.cloudwatchevents.aws.upbound.io":                                {},
+	"archiverule.accessanalyzer.aws.upbound.io":                              {},
+	"assessmenttarget.inspector.aws.upbound.io":                              {},
+	"assessmenttemplate.inspector.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"association.licensemanager.aws.upbound.io":                              {},
+	"association.ssm.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"attachment.autoscaling.aws.upbound.io":                                  {},
+	"attachment.elb.aws.upbound.io":                                          {},
+	"attachmentaccepter.networkmanager.aws.upbound.io":                       {},
+	"authenticationprofile.redshift.aws.upbound.io":                          {},
+	"authorizer.apigateway.aws.upbound.io":                                   {},
+	"authorizer.apigatewayv2.aws.upbound.io":                                 {},
+	"autoscalingconfigurationversion.apprunner.aws.upbound.io":               {tagsField: "tags", tagsShape: tagShapeMap},
+	"autoscalinggroup.autoscaling.aws.upbound.io":                            {tagsField: "tag", tagsShape: tagShapeList, tagEntryDefaults: map[string]any{"propagateAtLaunch": false}, resourceType: "autoscaling:autoScalingGroup", externalNameFromARN: regexp.MustCompile(`:autoScalingGroupName/(?P<name>.+)$`)},
+	"availabilityzonegroup.ec2.aws.upbound.io":                               {},
+	"awsconfigurationrecorderstatus.configservice.aws.upbound.io":            {},
+	"backendenvironment.amplify.aws.upbound.io":                              {},
+	"backendserverpolicy.elb.aws.upbound.io":                                 {},
+	"backup.fsx.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"backuppolicy.efs.aws.upbound.io":                                        {},
+	"basepathmapping.apigateway.aws.upbound.io":                              {},
+	"bgppeer.directconnect.aws.upbound.io":                                   {},
+	"bot.lexmodels.aws.upbound.io":                                           {},
+	"botalias.lexmodels.aws.upbound.io":                                      {},
+	"botassociation.connect.aws.upbound.io":                                  {},
+	"branch.amplify.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"broker.mq.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"bucket.lightsail.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"bucket.s3.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "s3", cloudFormationType: "AWS::S3::Bucket", awsConfigType: "AWS::S3::Bucket", externalNameFromARN: regexp.MustCompile(`^arn:[^:]+:s3:::(?P<name>[^/]+)$`)},
+	"bucketaccelerateconfiguration.s3.aws.upbound.io":                        {},
+	"bucketacl.s3.aws.upbound.io":                                            {},
+	"bucketanalyticsconfiguration.s3.aws.upbound.io":                         {},
+	"bucketcorsconfiguration.s3.aws.upbound.io":                              {},
+	"bucketintelligenttieringconfiguration.s3.aws.upbound.io":                {},
+	"bucketinventory.s3.aws.upbound.io":                                      {},
+	"bucketlifecycleconfiguration.s3.aws.upbound.io":                         {},
+	"bucketlogging.s3.aws.upbound.io":                                        {},
+	"bucketmetric.s3.aws.upbound.io":                                         {},
+	"bucketnotification.s3.aws.upbound.io":                                   {},
+	"bucketobject.s3.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"bucketobjectlockconfiguration.s3.aws.upbound.io":                        {},
+	"bucketownershipcontrols.s3.aws.upbound.io":                              {},
+	"bucketpolicy.s3.aws.upbound.io":                                         {},
+	"bucketpublicaccessblock.s3.aws.upbound.io":                              {},
+	"bucketreplicationconfiguration.s3.aws.upbound.io":                       {},
+	"bucketrequestpaymentconfiguration.s3.aws.upbound.io":                    {},
+	"bucketserversideencryptionconfiguration.s3.aws.upbound.io":              {},
+	"bucketversioning.s3.aws.upbound.io":                                     {},
+	"bucketwebsiteconfiguration.s3.aws.upbound.io":                           {},
+	"budget.budgets.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"budgetaction.budgets.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"budgetresourceassociation.servicecatalog.aws.upbound.io":                {},
+	"build.gamelift.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"bus.cloudwatchevents.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"buspolicy.cloudwatchevents.aws.upbound.io":                              {},
+	"bytematchset.waf.aws.upbound.io":                                        {},
+	"bytematchset.wafregional.aws.upbound.io":                                {},
+	"cachepolicy.cloudfront.aws.upbound.io":                                  {},
+	"capacityprovider.ecs.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"capacityreservation.ec2.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"carriergateway.ec2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"catalogdatabase.glue.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"catalogtable.glue.aws.upbound.io":                                       {},
+	"cell.route53recoveryreadiness.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"certificate.acm.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"certificate.acmpca.aws.upbound.io":                                      {},
+	"certificate.dms.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"certificate.iot.aws.upbound.io":                                         {},
+	"certificate.lightsail.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"certificateauthority.acmpca.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"certificateauthoritycertificate.acmpca.aws.upbound.io":                  {},
+	"certificatevalidation.acm.aws.upbound.io":                               {},
+	"channel.ivs.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"channel.medialive.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"channel.mediapackage.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"ciphertext.kms.aws.upbound.io":                                          {},
+	"classificationjob.macie2.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"classifier.glue.aws.upbound.io":                                         {},
+	"clientcertificate.apigateway.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"cloudformationstack.serverlessrepo.aws.upbound.io":                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.dax.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.docdb.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.ecs.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.eks.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "eks:cluster", cloudFormationType: "AWS::EKS::Cluster", awsConfigType: "AWS::EKS::Cluster", externalNameFromARN: regexp.MustCompile(`:cluster/(?P<name>[^/]+)$`)},
+	"cluster.elasticache.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.kafka.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.memorydb.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.neptune.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.rds.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "rds:cluster", externalNameFromARN: regexp.MustCompile(`:cluster:(?P<name>[^:]+)$`)},
+	"cluster.redshift.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"cluster.route53recoverycontrolconfig.aws.upbound.io":                    {},
+	"clusteractivitystream.rds.aws.upbound.io":                               {},
+	"clusterauth.eks.aws.upbound.io":                                         {},
+	"clustercapacityproviders.ecs.aws.upbound.io":                            {},
+	"clusterendpoint.neptune.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterendpoint.rds.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterinstance.docdb.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterinstance.neptune.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterinstance.rds.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterparametergroup.docdb.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterparametergroup.neptune.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterparametergroup.rds.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"clusterroleassociation.rds.aws.upbound.io":                              {},
+	"clustersnapshot.docdb.aws.upbound.io":                                   {},
+	"clustersnapshot.neptune.aws.upbound.io":                                 {},
+	"clustersnapshot.rds.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"codepipeline.codepipeline.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"coderepository.sagemaker.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"codesigningconfig.lambda.aws.upbound.io":                                {},
+	"cognitoidentitypoolproviderprincipaltag.cognitoidentity.aws.upbound.io": {},
+	"collection.opensearchserverless.aws.upbound.io":                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"component.imagebuilder.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"compositealarm.cloudwatch.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"conditionalforwarder.ds.aws.upbound.io":                                 {},
+	"configrule.configservice.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"configuration.kafka.aws.upbound.io":                                     {},
+	"configuration.mq.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"configurationaggregator.configservice.aws.upbound.io":                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"configurationprofile.appconfig.aws.upbound.io":                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"configurationrecorder.configservice.aws.upbound.io":                     {},
+	"configurationset.ses.aws.upbound.io":                                    {},
+	"configurationset.sesv2.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"configurationseteventdestination.sesv2.aws.upbound.io":                  {},
+	"configurationtemplate.elasticbeanstalk.aws.upbound.io":                  {},
+	"conformancepack.configservice.aws.upbound.io":                           {},
+	"connectattachment.networkmanager.aws.upbound.io":                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"connection.apprunner.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"connection.cloudwatchevents.aws.upbound.io":                             {},
+	"connection.codestarconnections.aws.upbound.io":                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"connection.directconnect.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"connection.glue.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"connection.networkmanager.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"connectionassociation.directconnect.aws.upbound.io":                     {},
+	"connector.kafkaconnect.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"connector.transfer.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"constraint.servicecatalog.aws.upbound.io":                               {},
+	"contactflow.connect.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"contactflowmodule.connect.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"container.mediastore.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"containerpolicy.mediastore.aws.upbound.io":                              {},
+	"containerrecipe.imagebuilder.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"containerservice.lightsail.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"contributorinsights.dynamodb.aws.upbound.io":                            {},
+	"controlpanel.route53recoverycontrolconfig.aws.upbound.io":               {},
+	"corenetwork.networkmanager.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"crawler.glue.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"customactiontype.codepipeline.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"customdataidentifier.macie2.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"customergateway.ec2.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"customergatewayassociation.networkmanager.aws.upbound.io":               {},
+	"customermanagedpolicyattachment.ssoadmin.aws.upbound.io":                {},
+	"customlayer.opsworks.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"customplugin.kafkaconnect.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"dashboard.cloudwatch.aws.upbound.io":                                    {},
+	"database.athena.aws.upbound.io":                                         {},
+	"database.timestreamwrite.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"datacatalog.athena.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"datacatalogencryptionsettings.glue.aws.upbound.io":                      {},
+	"datalakesettings.lakeformation.aws.upbound.io":                          {},
+	"datarepositoryassociation.fsx.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"dataset.dataexchange.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"datasource.appsync.aws.upbound.io":                                      {},
+	"datasource.kendra.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"dbinstanceautomatedbackupsreplication.rds.aws.upbound.io":               {},
+	"dbsnapshotcopy.rds.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"dedicatedippool.sesv2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"defaultnetworkacl.ec2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"defaultpatchbaseline.ssm.aws.upbound.io":                                {},
+	"defaultroutetable.ec2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"defaultsecuritygroup.ec2.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"defaultsubnet.ec2.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"defaultvpc.ec2.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"defaultvpcdhcpoptions.ec2.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"definition.cloudwatchlogs.aws.upbound.io":                               {},
+	"delegatedadministrator.organizations.aws.upbound.io":                    {},
+	"delegationset.route53.aws.upbound.io":                                   {},
+	"deliverychannel.configservice.aws.upbound.io":                           {},
+	"deliverystream.firehose.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"deployment.apigateway.aws.upbound.io":                                   {},
+	"deployment.apigatewayv2.aws.upbound.io":                                 {},
+	"deployment.appconfig.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"deploymentconfig.deploy.aws.upbound.io":                                 {},
+	"deploymentgroup.deploy.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"deploymentstrategy.appconfig.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"destination.cloudwatchlogs.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"destinationpolicy.cloudwatchlogs.aws.upbound.io":                        {},
+	"detector.guardduty.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"device.networkmanager.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"device.sagemaker.aws.upbound.io":                                        {},
+	"devicefleet.sagemaker.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"devicepool.devicefarm.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"directory.ds.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"directory.workspaces.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"directorybucket.s3.aws.upbound.io":                                      {},
+	"directoryconfig.appstream.aws.upbound.io":                               {},
+	"discoverer.schemas.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"disk.lightsail.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"diskattachment.lightsail.aws.upbound.io":                                {},
+	"distribution.cloudfront.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"distributionconfiguration.imagebuilder.aws.upbound.io":                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"document.ssm.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"documentationpart.apigateway.aws.upbound.io":                            {},
+	"documentationversion.apigateway.aws.upbound.io":                         {},
+	"domain.cloudsearch.aws.upbound.io":                                      {},
+	"domain.codeartifact.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"domain.elasticsearch.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"domain.lightsail.aws.upbound.io":                                        {},
+	"domain.opensearch.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"domain.sagemaker.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"domain.simpledb.aws.upbound.io":                                         {},
+	"domain.swf.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"domaindkim.ses.aws.upbound.io":                                          {},
+	"domainentry.lightsail.aws.upbound.io":                                   {},
+	"domainidentity.ses.aws.upbound.io":                                      {},
+	"domainmailfrom.ses.aws.upbound.io":                                      {},
+	"domainname.apigateway.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"domainname.apigatewayv2.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"domainpermissionspolicy.codeartifact.aws.upbound.io":                    {},
+	"domainpolicy.elasticsearch.aws.upbound.io":                              {},
+	"domainpolicy.opensearch.aws.upbound.io":                                 {},
+	"domainsamloptions.elasticsearch.aws.upbound.io":                         {},
+	"domainsamloptions.opensearch.aws.upbound.io":                            {},
+	"domainserviceaccesspolicy.cloudsearch.aws.upbound.io":                   {},
+	"ebsdefaultkmskey.ec2.aws.upbound.io":                                    {},
+	"ebsencryptionbydefault.ec2.aws.upbound.io":                              {},
+	"ebssnapshot.ec2.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"ebssnapshotcopy.ec2.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"ebssnapshotimport.ec2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"ebsvolume.ec2.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"ecsclusterlayer.opsworks.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"egressonlyinternetgateway.ec2.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"eip.ec2.aws.upbound.io":                                                 {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:elastic-ip", externalNameFromARN: regexp.MustCompile(`:elastic-ip/(?P<name>[^/]+)$`)},
+	"eipassociation.ec2.aws.upbound.io":                                      {},
+	"elb.elb.aws.upbound.io":                                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"emailidentity.ses.aws.upbound.io":                                       {},
+	"emailidentity.sesv2.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"emailidentityfeedbackattributes.sesv2.aws.upbound.io":                   {},
+	"emailidentitymailfromattributes.sesv2.aws.upbound.io":                   {},
+	"enabler.inspector2.aws.upbound.io":                                      {},
+	"encryptionconfig.xray.aws.upbound.io":                                   {},
+	"endpoint.dms.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"endpoint.route53resolver.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"endpoint.sagemaker.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"endpointaccess.redshift.aws.upbound.io":                                 {},
+	"endpointaccess.redshiftserverless.aws.upbound.io":                       {},
+	"endpointconfiguration.sagemaker.aws.upbound.io":                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"endpointgroup.globalaccelerator.aws.upbound.io":                         {},
+	"environment.appconfig.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"environment.mwaa.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"environmentec2.cloud9.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"environmentmembership.cloud9.aws.upbound.io":                            {},
+	"eventdatastore.cloudtrail.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"eventdestination.ses.aws.upbound.io":                                    {},
+	"eventintegration.appintegrations.aws.upbound.io":                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"eventsourcemapping.lambda.aws.upbound.io":                               {},
+	"eventsubscription.dms.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"eventsubscription.docdb.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"eventsubscription.neptune.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"eventsubscription.rds.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"eventsubscription.redshift.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"experience.kendra.aws.upbound.io":                                       {},
+	"experimenttemplate.fis.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"extension.appconfig.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"extensionassociation.appconfig.aws.upbound.io":                          {},
+	"externalkey.kms.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"fargateprofile.eks.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"feature.evidently.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"featuregroup.sagemaker.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"fieldlevelencryptionconfig.cloudfront.aws.upbound.io":                   {},
+	"fieldlevelencryptionprofile.cloudfront.aws.upbound.io":                  {},
+	"filesystem.efs.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"filesystempolicy.efs.aws.upbound.io":                                    {},
+	"filter.guardduty.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"findingaggregator.securityhub.aws.upbound.io":                           {},
+	"findingsfilter.macie2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"firewall.networkfirewall.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"firewallpolicy.networkfirewall.aws.upbound.io":                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"fleet.appstream.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"fleet.ec2.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"fleet.gamelift.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"fleetstackassociation.appstream.aws.upbound.io":                         {},
+	"flow.appflow.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"flowlog.ec2.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"framework.backup.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"function.appsync.aws.upbound.io":                                        {},
+	"function.cloudfront.aws.upbound.io":                                     {},
+	"function.lambda.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "lambda:function", cloudFormationType: "AWS::Lambda::Function", awsConfigType: "AWS::Lambda::Function", externalNameFromARN: regexp.MustCompile(`:function:(?P<name>[^:]+)(:[^:]+)?$`)},
+	"functioneventinvokeconfig.lambda.aws.upbound.io":                        {},
+	"functionurl.lambda.aws.upbound.io":                                      {},
+	"gamesessionqueue.gamelift.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"ganglialayer.opsworks.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"gateway.directconnect.aws.upbound.io":                                   {},
+	"gatewayassociation.directconnect.aws.upbound.io":                        {},
+	"gatewayassociationproposal.directconnect.aws.upbound.io":                {},
+	"gatewayresponse.apigateway.aws.upbound.io":                              {},
+	"gatewayroute.appmesh.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"geofencecollection.location.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"geomatchset.waf.aws.upbound.io":                                         {},
+	"geomatchset.wafregional.aws.upbound.io":                                 {},
+	"globalcluster.docdb.aws.upbound.io":                                     {},
+	"globalcluster.neptune.aws.upbound.io":                                   {},
+	"globalcluster.rds.aws.upbound.io":                                       {},
+	"globalnetwork.networkmanager.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"globalreplicationgroup.elasticache.aws.upbound.io":                      {},
+	"globalsettings.backup.aws.upbound.io":                                   {},
+	"globaltable.dynamodb.aws.upbound.io":                                    {},
+	"grant.kms.aws.upbound.io":                                               {},
+	"graph.detective.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"graphqlapi.appsync.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"group.cloudwatchlogs.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "logs:log-group", cloudFormationType: "AWS::Logs::LogGroup", externalNameFromARN: regexp.MustCompile(`:log-group:(?P<name>[^:]+)(:\*)?$`)},
+	"group.iam.aws.upbound.io":                                               {global: true},
+	"group.identitystore.aws.upbound.io":                                     {},
+	"group.quicksight.aws.upbound.io":                                        {},
+	"group.resourcegroups.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"group.xray.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"groupmembership.iam.aws.upbound.io":                                     {global: true},
+	"groupmembership.identitystore.aws.upbound.io":                           {},
+	"grouppolicyattachment.iam.aws.upbound.io":                               {global: true},
+	"grouptag.autoscaling.aws.upbound.io":                                    {tagsField: "tag", tagsShape: tagShapeList},
+	"haproxylayer.opsworks.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"healthcheck.route53.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"host.codestarconnections.aws.upbound.io":                                {},
+	"host.ec2.aws.upbound.io":                                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"hostedconfigurationversion.appconfig.aws.upbound.io":                    {},
+	"hostedprivatevirtualinterface.directconnect.aws.upbound.io":             {},
+	"hostedprivatevirtualinterfaceaccepter.directconnect.aws.upbound.io":     {tagsField: "tags", tagsShape: tagShapeMap},
+	"hostedpublicvirtualinterface.directconnect.aws.upbound.io":              {},
+	"hostedpublicvirtualinterfaceaccepter.directconnect.aws.upbound.io":      {tagsField: "tags", tagsShape: tagShapeMap},
+	"hostedtransitvirtualinterface.directconnect.aws.upbound.io":             {},
+	"hostedtransitvirtualinterfaceaccepter.directconnect.aws.upbound.io":     {tagsField: "tags", tagsShape: tagShapeMap},
+	"hostedzonednssec.route53.aws.upbound.io":                                {},
+	"hoursofoperation.connect.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"hsmclientcertificate.redshift.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"hsmconfiguration.redshift.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"httpnamespace.servicediscovery.aws.upbound.io":                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"identitynotificationtopic.ses.aws.upbound.io":                           {},
+	"identitypolicy.ses.aws.upbound.io":                                      {},
+	"identityprovider.cognitoidp.aws.upbound.io":                             {},
+	"identityproviderconfig.eks.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"image.imagebuilder.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"image.sagemaker.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"imagebuilder.appstream.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"imagepipeline.imagebuilder.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"imagerecipe.imagebuilder.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"imageversion.sagemaker.aws.upbound.io":                                  {},
+	"index.kendra.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"indexingconfiguration.iot.aws.upbound.io":                               {},
+	"infrastructureconfiguration.imagebuilder.aws.upbound.io":                {tagsField: "tags", tagsShape: tagShapeMap},
+	"input.medialive.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"inputsecuritygroup.medialive.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"insight.securityhub.aws.upbound.io":                                     {},
+	"instance.connect.aws.upbound.io":                                        {},
+	"instance.ec2.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:instance", externalNameFromARN: regexp.MustCompile(`:instance/(?P<name>[^/]+)$`)},
+	"instance.lightsail.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"instance.opsworks.aws.upbound.io":                                       {global: true},
+	"instance.rds.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "rds:db"},
+	"instanceaccesscontrolattributes.ssoadmin.aws.upbound.io":                {},
+	"instanceprofile.devicefarm.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"instanceprofile.iam.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap, global: true, resourceType: "iam:instance-profile", externalNameFromARN: regexp.MustCompile(`:instance-profile/(.+/)?(?P<name>[^/]+)$`)},
+	"instancepublicports.lightsail.aws.upbound.io":                           {},
+	"instanceroleassociation.rds.aws.upbound.io":                             {},
+	"instancestate.ec2.aws.upbound.io":                                       {},
+	"instancestorageconfig.connect.aws.upbound.io":                           {},
+	"integration.apigateway.aws.upbound.io":                                  {},
+	"integration.apigatewayv2.aws.upbound.io":                                {},
+	"integrationresponse.apigateway.aws.upbound.io":                          {},
+	"integrationresponse.apigatewayv2.aws.upbound.io":                        {},
+	"intent.lexmodels.aws.upbound.io":                                        {},
+	"internetgateway.ec2.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:internet-gateway", cloudFormationType: "AWS::EC2::InternetGateway", awsConfigType: "AWS::EC2::InternetGateway", externalNameFromARN: regexp.MustCompile(`:internet-gateway/(?P<name>[^/]+)$`)},
+	"invitationaccepter.detective.aws.upbound.io":                            {},
+	"invitationaccepter.macie2.aws.upbound.io":                               {},
+	"inviteaccepter.securityhub.aws.upbound.io":                              {},
+	"invocation.lambda.aws.upbound.io":                                       {},
+	"ipgroup.workspaces.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"ipset.waf.aws.upbound.io":                                               {},
+	"ipset.wafregional.aws.upbound.io":                                       {},
+	"ipset.wafv2.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"javaapplayer.opsworks.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"job.glue.aws.upbound.io":                                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"jobdefinition.batch.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"key.kms.aws.upbound.io":                                                 {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "kms:key", cloudFormationType: "AWS::KMS::Key", awsConfigType: "AWS::KMS::Key", externalNameFromARN: regexp.MustCompile(`:key/(?P<name>[^/]+)$`)},
+	"keygroup.cloudfront.aws.upbound.io":                                     {},
+	"keypair.ec2.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"keypair.lightsail.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"keyspace.keyspaces.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"kinesisstreamingdestination.dynamodb.aws.upbound.io":                    {},
+	"lag.directconnect.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"lambdafunctionassociation.connect.aws.upbound.io":                       {},
+	"languagemodel.transcribe.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"launchconfiguration.autoscaling.aws.upbound.io":                         {},
+	"launchtemplate.ec2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:launch-template", externalNameFromARN: regexp.MustCompile(`:launch-template/(?P<name>[^/]+)$`)},
+	"layerversion.lambda.aws.upbound.io":                                     {},
+	"layerversionpermission.lambda.aws.upbound.io":                           {},
+	"lb.elbv2.aws.upbound.io":                                                {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "elasticloadbalancing:loadbalancer", externalNameFromARN: regexp.MustCompile(`^(?P<name>arn:.+)$`)},
+	"lb.lightsail.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"lbattachment.lightsail.aws.upbound.io":                                  {},
+	"lbcertificate.lightsail.aws.upbound.io":                                 {},
+	"lbcookiestickinesspolicy.elb.aws.upbound.io":                            {},
+	"lblistener.elbv2.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"lblistenercertificate.elbv2.aws.upbound.io":                             {},
+	"lblistenerrule.elbv2.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"lbsslnegotiationpolicy.elb.aws.upbound.io":                              {},
+	"lbstickinesspolicy.lightsail.aws.upbound.io":                            {},
+	"lbtargetgroup.elbv2.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "elasticloadbalancing:targetgroup", externalNameFromARN: regexp.MustCompile(`^(?P<name>arn:.+)$`)},
+	"lbtargetgroupattachment.elbv2.aws.upbound.io":                           {},
+	"lbtruststore.elbv2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"ledger.qldb.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"licenseassociation.grafana.aws.upbound.io":                              {},
+	"licenseconfiguration.licensemanager.aws.upbound.io":                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"lifecyclehook.autoscaling.aws.upbound.io":                               {},
+	"lifecyclepolicy.dlm.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"lifecyclepolicy.ecr.aws.upbound.io":                                     {},
+	"lifecyclepolicy.opensearchserverless.aws.upbound.io":                    {},
+	"link.networkmanager.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"linkassociation.networkmanager.aws.upbound.io":                          {},
+	"listener.globalaccelerator.aws.upbound.io":                              {},
+	"listenerpolicy.elb.aws.upbound.io":                                      {},
+	"locations3.datasync.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"loggingconfiguration.networkfirewall.aws.upbound.io":                    {},
+	"loggingoptions.iot.aws.upbound.io":                                      {},
+	"lustrefilesystem.fsx.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"mainroutetableassociation.ec2.aws.upbound.io":                           {},
+	"maintenancewindow.ssm.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"maintenancewindowtarget.ssm.aws.upbound.io":                             {},
+	"maintenancewindowtask.ssm.aws.upbound.io":                               {},
+	"managedpolicyattachment.ssoadmin.aws.upbound.io":                        {},
+	"managedprefixlist.ec2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"managedprefixlistentry.ec2.aws.upbound.io":                              {},
+	"member.detective.aws.upbound.io":                                        {},
+	"member.guardduty.aws.upbound.io":                                        {},
+	"member.macie2.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"member.securityhub.aws.upbound.io":                                      {},
+	"memcachedlayer.opsworks.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"mesh.appmesh.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"method.apigateway.aws.upbound.io":                                       {},
+	"methodresponse.apigateway.aws.upbound.io":                               {},
+	"methodsettings.apigateway.aws.upbound.io":                               {},
+	"metricalarm.cloudwatch.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"metricfilter.cloudwatchlogs.aws.upbound.io":                             {},
+	"metricsdestination.rum.aws.upbound.io":                                  {},
+	"metricstream.cloudwatch.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"model.apigateway.aws.upbound.io":                                        {},
+	"model.apigatewayv2.aws.upbound.io":                                      {},
+	"model.sagemaker.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"modelpackagegroup.sagemaker.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"modelpackagegrouppolicy.sagemaker.aws.upbound.io":                       {},
+	"monitoringsubscription.cloudfront.aws.upbound.io":                       {},
+	"mounttarget.efs.aws.upbound.io":                                         {},
+	"multiplex.medialive.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"multiregionaccesspoint.s3control.aws.upbound.io":                        {},
+	"multiregionaccesspointpolicy.s3control.aws.upbound.io":                  {},
+	"mysqllayer.opsworks.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"namedquery.athena.aws.upbound.io":                                       {},
+	"natgateway.ec2.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:natgateway", cloudFormationType: "AWS::EC2::NatGateway", awsConfigType: "AWS::EC2::NatGateway", externalNameFromARN: regexp.MustCompile(`:natgateway/(?P<name>[^/]+)$`)},
+	"networkacl.ec2.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"networkaclrule.ec2.aws.upbound.io":                                      {},
+	"networkinsightsanalysis.ec2.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"networkinsightspath.ec2.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"networkinterface.ec2.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"networkinterfaceattachment.ec2.aws.upbound.io":                          {},
+	"networkinterfacesgattachment.ec2.aws.upbound.io":                        {},
+	"networkperformancemetricsubscription.vpc.aws.upbound.io":                {},
+	"networkprofile.devicefarm.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"nodegroup.eks.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"nodejsapplayer.opsworks.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"notebookinstance.sagemaker.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"notebookinstancelifecycleconfiguration.sagemaker.aws.upbound.io":        {},
+	"notification.autoscaling.aws.upbound.io":                                {},
+	"notificationrule.codestarnotifications.aws.upbound.io":                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"object.s3.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"objectcopy.s3.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"objectlambdaaccesspoint.s3control.aws.upbound.io":                       {},
+	"objectlambdaaccesspointpolicy.s3control.aws.upbound.io":                 {},
+	"observabilityconfiguration.apprunner.aws.upbound.io":                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"ontapfilesystem.fsx.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"ontapstoragevirtualmachine.fsx.aws.upbound.io":                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"openidconnectprovider.iam.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"optiongroup.rds.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"organization.organizations.aws.upbound.io":                              {},
+	"organizationalunit.organizations.aws.upbound.io":                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"originaccesscontrol.cloudfront.aws.upbound.io":                          {},
+	"originaccessidentity.cloudfront.aws.upbound.io":                         {},
+	"originrequestpolicy.cloudfront.aws.upbound.io":                          {},
+	"parameter.ssm.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"parametergroup.dax.aws.upbound.io":                                      {},
+	"parametergroup.elasticache.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"parametergroup.memorydb.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"parametergroup.neptune.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"parametergroup.rds.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"parametergroup.redshift.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"patchbaseline.ssm.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"patchgroup.ssm.aws.upbound.io":                                          {},
+	"permission.acmpca.aws.upbound.io":                                       {},
+	"permission.cloudwatchevents.aws.upbound.io":                             {},
+	"permission.lambda.aws.upbound.io":                                       {},
+	"permission.opsworks.aws.upbound.io":                                     {global: true},
+	"permissions.lakeformation.aws.upbound.io":                               {},
+	"permissionsboundaryattachment.ssoadmin.aws.upbound.io":                  {},
+	"permissionset.ssoadmin.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"permissionsetinlinepolicy.ssoadmin.aws.upbound.io":                      {},
+	"phonenumber.connect.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"phpapplayer.opsworks.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"pipe.pipes.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"pipeline.datapipeline.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"pipeline.elastictranscoder.aws.upbound.io":                              {},
+	"placeindex.location.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"placementgroup.ec2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"plan.backup.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"platformapplication.sns.aws.upbound.io":                                 {},
+	"podidentityassociation.eks.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"policy.acmpca.aws.upbound.io":                                           {},
+	"policy.appautoscaling.aws.upbound.io":                                   {},
+	"policy.autoscaling.aws.upbound.io":                                      {},
+	"policy.elb.aws.upbound.io":                                              {},
+	"policy.iam.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap, global: true, resourceType: "iam:policy", cloudFormationType: "AWS::IAM::ManagedPolicy", awsConfigType: "AWS::IAM::Policy", externalNameFromARN: regexp.MustCompile(`^(?P<name>arn:.+)$`)},
+	"policy.iot.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"policy.organizations.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"policyattachment.iot.aws.upbound.io":                                    {},
+	"policyattachment.organizations.aws.upbound.io":                          {},
+	"pool.cognitoidentity.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"poolrolesattachment.cognitoidentity.aws.upbound.io":                     {},
+	"portfolio.servicecatalog.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"portfolioshare.servicecatalog.aws.upbound.io":                           {},
+	"preset.elastictranscoder.aws.upbound.io":                                {},
+	"principalassociation.ram.aws.upbound.io":                                {},
+	"principalportfolioassociation.servicecatalog.aws.upbound.io":            {},
+	"privatednsnamespace.servicediscovery.aws.upbound.io":                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"privatevirtualinterface.directconnect.aws.upbound.io":                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"product.servicecatalog.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"productportfolioassociation.servicecatalog.aws.upbound.io":              {},
+	"productsubscription.securityhub.aws.upbound.io":                         {},
+	"profile.rolesanywhere.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"profilinggroup.codeguruprofiler.aws.upbound.io":                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"project.devicefarm.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"project.evidently.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"provisionedconcurrencyconfig.lambda.aws.upbound.io":                     {},
+	"provisioningartifact.servicecatalog.aws.upbound.io":                     {},
+	"provisioningtemplate.iot.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"proxy.rds.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"proxydefaulttargetgroup.rds.aws.upbound.io":                             {},
+	"proxyendpoint.rds.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"proxyprotocolpolicy.elb.aws.upbound.io":                                 {},
+	"proxytarget.rds.aws.upbound.io":                                         {},
+	"publicdnsnamespace.servicediscovery.aws.upbound.io":                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"publickey.cloudfront.aws.upbound.io":                                    {},
+	"publicvirtualinterface.directconnect.aws.upbound.io":                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"pullthroughcacherule.ecr.aws.upbound.io":                                {},
+	"querysuggestionsblocklist.kendra.aws.upbound.io":                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"queue.connect.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"queue.mediaconvert.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"queue.sqs.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "sqs", cloudFormationType: "AWS::SQS::Queue", awsConfigType: "AWS::SQS::Queue"},
+	"queuepolicy.sqs.aws.upbound.io":                                         {},
+	"queueredriveallowpolicy.sqs.aws.upbound.io":                             {},
+	"queueredrivepolicy.sqs.aws.upbound.io":                                  {},
+	"quickconnect.connect.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"railsapplayer.opsworks.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"ratebasedrule.waf.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"ratebasedrule.wafregional.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"rdsdbinstance.opsworks.aws.upbound.io":                                  {global: true},
+	"readinesscheck.route53recoveryreadiness.aws.upbound.io":                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"realtimelogconfig.cloudfront.aws.upbound.io":                            {},
+	"receiptfilter.ses.aws.upbound.io":                                       {},
+	"receiptrule.ses.aws.upbound.io":                                         {},
+	"receiptruleset.ses.aws.upbound.io":                                      {},
+	"record.route53.aws.upbound.io":                                          {},
+	"recordingconfiguration.ivs.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"recoverygroup.route53recoveryreadiness.aws.upbound.io":                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"redshiftserverlessnamespace.redshiftserverless.aws.upbound.io":          {tagsField: "tags", tagsShape: tagShapeMap},
+	"regexmatchset.waf.aws.upbound.io":                                       {},
+	"regexmatchset.wafregional.aws.upbound.io":                               {},
+	"regexpatternset.waf.aws.upbound.io":                                     {},
+	"regexpatternset.wafregional.aws.upbound.io":                             {},
+	"regexpatternset.wafv2.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"region.account.aws.upbound.io":                                          {},
+	"regionsettings.backup.aws.upbound.io":                                   {},
+	"registry.glue.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"registry.schemas.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"registrypolicy.ecr.aws.upbound.io":                                      {},
+	"registryscanningconfiguration.ecr.aws.upbound.io":                       {},
+	"remediationconfiguration.configservice.aws.upbound.io":                  {},
+	"replicaexternalkey.kms.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"replicakey.kms.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"replicationconfiguration.ecr.aws.upbound.io":                            {},
+	"replicationconfiguration.efs.aws.upbound.io":                            {},
+	"replicationgroup.elasticache.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"replicationinstance.dms.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"replicationsubnetgroup.dms.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"replicationtask.dms.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"reportdefinition.cur.aws.upbound.io":                                    {},
+	"reportplan.backup.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"repository.codeartifact.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"repository.codecommit.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"repository.ecr.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ecr:repository", cloudFormationType: "AWS::ECR::Repository", awsConfigType: "AWS::ECR::Repository", externalNameFromARN: regexp.MustCompile(`:repository/(?P<name>.+)$`)},
+	"repository.ecrpublic.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"repositorypermissionspolicy.codeartifact.aws.upbound.io":                {},
+	"repositorypolicy.ecr.aws.upbound.io":                                    {},
+	"repositorypolicy.ecrpublic.aws.upbound.io":                              {},
+	"requestvalidator.apigateway.aws.upbound.io":                             {},
+	"resolver.appsync.aws.upbound.io":                                        {},
+	"resolverconfig.route53.aws.upbound.io":                                  {},
+	"resource.apigateway.aws.upbound.io":                                     {},
+	"resource.cloudcontrol.aws.upbound.io":                                   {},
+	"resource.lakeformation.aws.upbound.io":                                  {},
+	"resourceassociation.ram.aws.upbound.io":                                 {},
+	"resourcedatasync.ssm.aws.upbound.io":                                    {},
+	"resourcegroup.inspector.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"resourcepolicy.cloudwatchlogs.aws.upbound.io":                           {},
+	"resourcepolicy.dynamodb.aws.upbound.io":                                 {},
+	"resourcepolicy.glue.aws.upbound.io":                                     {},
+	"resourcepolicy.redshiftserverless.aws.upbound.io":                       {},
+	"resourceserver.cognitoidp.aws.upbound.io":                               {},
+	"resourceset.route53recoveryreadiness.aws.upbound.io":                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"resourceshare.ram.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"resourceshareaccepter.ram.aws.upbound.io":                               {},
+	"responseheaderspolicy.cloudfront.aws.upbound.io":                        {},
+	"restapi.apigateway.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"restapipolicy.apigateway.aws.upbound.io":                                {},
+	"revision.dataexchange.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"riskconfiguration.cognitoidp.aws.upbound.io":                            {},
+	"role.iam.aws.upbound.io":                                                {tagsField: "tags", tagsShape: tagShapeMap, global: true, resourceType: "iam:role", cloudFormationType: "AWS::IAM::Role", awsConfigType: "AWS::IAM::Role", externalNameFromARN: regexp.MustCompile(`:role/(.+/)?(?P<name>[^/]+)$`)},
+	"rolealias.iot.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"roleassociation.grafana.aws.upbound.io":                                 {},
+	"rolepolicy.iam.aws.upbound.io":                                          {global: true},
+	"rolepolicyattachment.iam.aws.upbound.io":                                {global: true},
+	"route.apigatewayv2.aws.upbound.io":                                      {},
+	"route.appmesh.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"route.ec2.aws.upbound.io":                                               {},
+	"routecalculator.location.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"routeresponse.apigatewayv2.aws.upbound.io":                              {},
+	"routetable.ec2.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:route-table", cloudFormationType: "AWS::EC2::RouteTable", awsConfigType: "AWS::EC2::RouteTable", externalNameFromARN: regexp.MustCompile(`:route-table/(?P<name>[^/]+)$`)},
+	"routetableassociation.ec2.aws.upbound.io":                               {},
+	"routingcontrol.route53recoverycontrolconfig.aws.upbound.io":             {},
+	"routingprofile.connect.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"rule.cloudwatchevents.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"rule.route53resolver.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"rule.waf.aws.upbound.io":                                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"rule.wafregional.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"ruleassociation.route53resolver.aws.upbound.io":                         {},
+	"rulegroup.networkfirewall.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"rulegroupnamespace.amp.aws.upbound.io":                                  {},
+	"s3endpoint.dms.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"safetyrule.route53recoverycontrolconfig.aws.upbound.io":                 {},
+	"samlprovider.iam.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"samplingrule.xray.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"scalingplan.autoscalingplans.aws.upbound.io":                            {},
+	"schedule.autoscaling.aws.upbound.io":                                    {},
+	"schedule.scheduler.aws.upbound.io":                                      {},
+	"scheduledaction.appautoscaling.aws.upbound.io":                          {},
+	"scheduledaction.redshift.aws.upbound.io":                                {},
+	"schedulegroup.scheduler.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"schedulingpolicy.batch.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"schema.glue.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"schema.schemas.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"scramsecretassociation.kafka.aws.upbound.io":                            {},
+	"script.gamelift.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"secret.secretsmanager.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "secretsmanager:secret", cloudFormationType: "AWS::SecretsManager::Secret", awsConfigType: "AWS::SecretsManager::Secret", externalNameFromARN: regexp.MustCompile(`^(?P<name>arn:.+)$`)},
+	"secretpolicy.secretsmanager.aws.upbound.io":                             {},
+	"secretrotation.secretsmanager.aws.upbound.io":                           {},
+	"secretversion.secretsmanager.aws.upbound.io":                            {},
+	"securityconfig.opensearchserverless.aws.upbound.io":                     {},
+	"securityconfiguration.emr.aws.upbound.io":                               {},
+	"securityconfiguration.glue.aws.upbound.io":                              {},
+	"securitygroup.ec2.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:security-group", cloudFormationType: "AWS::EC2::SecurityGroup", awsConfigType: "AWS::EC2::SecurityGroup", externalNameFromARN: regexp.MustCompile(`:security-group/(?P<name>[^/]+)$`)},
+	"securitygroupegressrule.ec2.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:security-group-rule", externalNameFromARN: regexp.MustCompile(`:security-group-rule/(?P<name>[^/]+)$`)},
+	"securitygroupingressrule.ec2.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:security-group-rule", externalNameFromARN: regexp.MustCompile(`:security-group-rule/(?P<name>[^/]+)$`)},
+	"securitygrouprule.ec2.aws.upbound.io":                                   {},
+	"securitypolicy.opensearchserverless.aws.upbound.io":                     {},
+	"securityprofile.connect.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"segment.evidently.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"selection.backup.aws.upbound.io":                                        {},
+	"serialconsoleaccess.ec2.aws.upbound.io":                                 {},
+	"server.transfer.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"servercertificate.iam.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"serverlesscache.elasticache.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"serverlesscluster.kafka.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"service.apprunner.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"service.ecs.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"service.servicediscovery.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"serviceaction.servicecatalog.aws.upbound.io":                            {},
+	"servicecatalogportfoliostatus.sagemaker.aws.upbound.io":                 {},
+	"servicelinkedrole.iam.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"servicequota.servicequotas.aws.upbound.io":                              {},
+	"servicesetting.ssm.aws.upbound.io":                                      {},
+	"servicespecificcredential.iam.aws.upbound.io":                           {global: true},
+	"shareddirectory.ds.aws.upbound.io":                                      {},
+	"signingcertificate.iam.aws.upbound.io":                                  {global: true},
+	"signingjob.signer.aws.upbound.io":                                       {},
+	"signingprofile.signer.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"signingprofilepermission.signer.aws.upbound.io":                         {},
+	"site.networkmanager.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"sizeconstraintset.waf.aws.upbound.io":                                   {},
+	"sizeconstraintset.wafregional.aws.upbound.io":                           {},
+	"slottype.lexmodels.aws.upbound.io":                                      {},
+	"smschannel.pinpoint.aws.upbound.io":                                     {},
+	"smspreferences.sns.aws.upbound.io":                                      {},
+	"snapshot.memorydb.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"snapshot.rds.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"snapshot.redshiftserverless.aws.upbound.io":                             {},
+	"snapshotcopygrant.redshift.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"snapshotcreatevolumepermission.ec2.aws.upbound.io":                      {},
+	"snapshotschedule.redshift.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"snapshotscheduleassociation.redshift.aws.upbound.io":                    {},
+	"space.sagemaker.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"spotdatafeedsubscription.ec2.aws.upbound.io":                            {},
+	"spotfleetrequest.ec2.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"spotinstancerequest.ec2.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"sqlinjectionmatchset.waf.aws.upbound.io":                                {},
+	"sqlinjectionmatchset.wafregional.aws.upbound.io":                        {},
+	"sshkey.transfer.aws.upbound.io":                                         {},
+	"stack.appstream.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"stack.cloudformation.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"stack.opsworks.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"stackset.cloudformation.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"stacksetinstance.cloudformation.aws.upbound.io":                         {},
+	"stage.apigateway.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"stage.apigatewayv2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"standardssubscription.securityhub.aws.upbound.io":                       {},
+	"statemachine.sfn.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"staticip.lightsail.aws.upbound.io":                                      {},
+	"staticipattachment.lightsail.aws.upbound.io":                            {},
+	"staticweblayer.opsworks.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"storagelensconfiguration.s3control.aws.upbound.io":                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"stream.cloudwatchlogs.aws.upbound.io":                                   {},
+	"stream.kinesis.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"stream.kinesisvideo.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"stream.qldb.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"streamconsumer.kinesis.aws.upbound.io":                                  {},
+	"studiolifecycleconfig.sagemaker.aws.upbound.io":                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"subnet.ec2.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:subnet", cloudFormationType: "AWS::EC2::Subnet", awsConfigType: "AWS::EC2::Subnet", externalNameFromARN: regexp.MustCompile(`:subnet/(?P<name>[^/]+)$`)},
+	"subnetcidrreservation.ec2.aws.upbound.io":                               {},
+	"subnetgroup.dax.aws.upbound.io":                                         {},
+	"subnetgroup.docdb.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"subnetgroup.elasticache.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"subnetgroup.memorydb.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"subnetgroup.neptune.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"subnetgroup.rds.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"subnetgroup.redshift.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"subscriptionfilter.cloudwatchlogs.aws.upbound.io":                       {},
+	"table.dynamodb.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "dynamodb:table", cloudFormationType: "AWS::DynamoDB::Table", awsConfigType: "AWS::DynamoDB::Table", externalNameFromARN: regexp.MustCompile(`:table/(?P<name>[^/]+)$`)},
+	"table.keyspaces.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"table.timestreamwrite.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"tableitem.dynamodb.aws.upbound.io":                                      {},
+	"tablereplica.dynamodb.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"tag.dynamodb.aws.upbound.io":                                            {},
+	"tag.ec2.aws.upbound.io":                                                 {},
+	"tag.transfer.aws.upbound.io":                                            {},
+	"tagoption.servicecatalog.aws.upbound.io":                                {},
+	"tagoptionresourceassociation.servicecatalog.aws.upbound.io":             {},
+	"target.appautoscaling.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"target.cloudwatchevents.aws.upbound.io":                                 {},
+	"task.datasync.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"taskdefinition.ecs.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"template.ses.aws.upbound.io":                                            {},
+	"testgridproject.devicefarm.aws.upbound.io":                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"thesaurus.kendra.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"thing.iot.aws.upbound.io":                                               {},
+	"thinggroup.iot.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"thinggroupmembership.iot.aws.upbound.io":                                {},
+	"thingprincipalattachment.iot.aws.upbound.io":                            {},
+	"thingtype.iot.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"topic.sns.aws.upbound.io":                                               {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "sns", cloudFormationType: "AWS::SNS::Topic", awsConfigType: "AWS::SNS::Topic", externalNameFromARN: regexp.MustCompile(`^(?P<name>arn:.+)$`)},
+	"topicpolicy.sns.aws.upbound.io":                                         {},
+	"topicrule.iot.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"topicruledestination.iot.aws.upbound.io":                                {},
+	"topicsubscription.sns.aws.upbound.io":                                   {},
+	"tracker.location.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"trackerassociation.location.aws.upbound.io":                             {},
+	"trafficmirrorfilter.ec2.aws.upbound.io":                                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"trafficmirrorfilterrule.ec2.aws.upbound.io":                             {},
+	"trafficpolicy.route53.aws.upbound.io":                                   {},
+	"trafficpolicyinstance.route53.aws.upbound.io":                           {},
+	"trail.cloudtrail.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgateway.ec2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewayconnect.ec2.aws.upbound.io":                               {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewayconnectpeer.ec2.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewayconnectpeerassociation.networkmanager.aws.upbound.io":     {},
+	"transitgatewaymulticastdomain.ec2.aws.upbound.io":                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewaymulticastdomainassociation.ec2.aws.upbound.io":            {},
+	"transitgatewaymulticastgroupmember.ec2.aws.upbound.io":                  {},
+	"transitgatewaymulticastgroupsource.ec2.aws.upbound.io":                  {},
+	"transitgatewaypeeringattachment.ec2.aws.upbound.io":                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewaypeeringattachmentaccepter.ec2.aws.upbound.io":             {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewaypolicytable.ec2.aws.upbound.io":                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewayprefixlistreference.ec2.aws.upbound.io":                   {},
+	"transitgatewayregistration.networkmanager.aws.upbound.io":               {},
+	"transitgatewayroute.ec2.aws.upbound.io":                                 {},
+	"transitgatewayroutetable.ec2.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewayroutetableassociation.ec2.aws.upbound.io":                 {},
+	"transitgatewayroutetablepropagation.ec2.aws.upbound.io":                 {},
+	"transitgatewayvpcattachment.ec2.aws.upbound.io":                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitgatewayvpcattachmentaccepter.ec2.aws.upbound.io":                 {tagsField: "tags", tagsShape: tagShapeMap},
+	"transitvirtualinterface.directconnect.aws.upbound.io":                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"trigger.codecommit.aws.upbound.io":                                      {},
+	"trigger.glue.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"upload.devicefarm.aws.upbound.io":                                       {},
+	"usagelimit.redshift.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"usagelimit.redshiftserverless.aws.upbound.io":                           {},
+	"usageplan.apigateway.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"usageplankey.apigateway.aws.upbound.io":                                 {},
+	"user.appstream.aws.upbound.io":                                          {},
+	"user.cognitoidp.aws.upbound.io":                                         {},
+	"user.connect.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"user.elasticache.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"user.iam.aws.upbound.io":                                                {tagsField: "tags", tagsShape: tagShapeMap, global: true, resourceType: "iam:user", cloudFormationType: "AWS::IAM::User", awsConfigType: "AWS::IAM::User", externalNameFromARN: regexp.MustCompile(`:user/(.+/)?(?P<name>[^/]+)$`)},
+	"user.identitystore.aws.upbound.io":                                      {},
+	"user.memorydb.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"user.mq.aws.upbound.io":                                                 {},
+	"user.quicksight.aws.upbound.io":                                         {},
+	"user.transfer.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"userdefinedfunction.glue.aws.upbound.io":                                {},
+	"usergroup.cognitoidp.aws.upbound.io":                                    {},
+	"usergroup.elasticache.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"usergroupmembership.iam.aws.upbound.io":                                 {global: true},
+	"userhierarchystructure.connect.aws.upbound.io":                          {},
+	"useringroup.cognitoidp.aws.upbound.io":                                  {},
+	"userloginprofile.iam.aws.upbound.io":                                    {global: true},
+	"userpolicyattachment.iam.aws.upbound.io":                                {global: true},
+	"userpool.cognitoidp.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"userpoolclient.cognitoidp.aws.upbound.io":                               {},
+	"userpooldomain.cognitoidp.aws.upbound.io":                               {},
+	"userpooluicustomization.cognitoidp.aws.upbound.io":                      {},
+	"userprofile.opsworks.aws.upbound.io":                                    {global: true},
+	"userprofile.sagemaker.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"usersshkey.iam.aws.upbound.io":                                          {global: true},
+	"userstackassociation.appstream.aws.upbound.io":                          {},
+	"vault.backup.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"vault.glacier.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"vaultlock.glacier.aws.upbound.io":                                       {},
+	"vaultlockconfiguration.backup.aws.upbound.io":                           {},
+	"vaultnotifications.backup.aws.upbound.io":                               {},
+	"vaultpolicy.backup.aws.upbound.io":                                      {},
+	"virtualgateway.appmesh.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"virtualmfadevice.iam.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap, global: true},
+	"virtualnode.appmesh.aws.upbound.io":                                     {tagsField: "tags", tagsShape: tagShapeMap},
+	"virtualrouter.appmesh.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"virtualservice.appmesh.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"vocabulary.connect.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"vocabulary.transcribe.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"vocabularyfilter.transcribe.aws.upbound.io":                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"voiceconnector.chime.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"voiceconnectorgroup.chime.aws.upbound.io":                               {},
+	"voiceconnectorlogging.chime.aws.upbound.io":                             {},
+	"voiceconnectororigination.chime.aws.upbound.io":                         {},
+	"voiceconnectorstreaming.chime.aws.upbound.io":                           {},
+	"voiceconnectortermination.chime.aws.upbound.io":                         {},
+	"voiceconnectorterminationcredentials.chime.aws.upbound.io":              {},
+	"volumeattachment.ec2.aws.upbound.io":                                    {},
+	"vpc.ec2.aws.upbound.io":                                                 {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "ec2:vpc", cloudFormationType: "AWS::EC2::VPC", awsConfigType: "AWS::EC2::VPC", externalNameFromARN: regexp.MustCompile(`:vpc/(?P<name>[^/]+)$`)},
+	"vpcassociationauthorization.route53.aws.upbound.io":                     {},
+	"vpcattachment.networkmanager.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcconnector.apprunner.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcdhcpoptions.ec2.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcdhcpoptionsassociation.ec2.aws.upbound.io":                           {},
+	"vpcendpoint.ec2.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcendpoint.opensearchserverless.aws.upbound.io":                        {},
+	"vpcendpointconnectionnotification.ec2.aws.upbound.io":                   {},
+	"vpcendpointroutetableassociation.ec2.aws.upbound.io":                    {},
+	"vpcendpointsecuritygroupassociation.ec2.aws.upbound.io":                 {},
+	"vpcendpointservice.ec2.aws.upbound.io":                                  {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcendpointserviceallowedprincipal.ec2.aws.upbound.io":                  {},
+	"vpcendpointsubnetassociation.ec2.aws.upbound.io":                        {},
+	"vpcipam.ec2.aws.upbound.io":                                             {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcipampool.ec2.aws.upbound.io":                                         {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcipampoolcidr.ec2.aws.upbound.io":                                     {},
+	"vpcipampoolcidrallocation.ec2.aws.upbound.io":                           {},
+	"vpcipamscope.ec2.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcipv4cidrblockassociation.ec2.aws.upbound.io":                         {},
+	"vpclink.apigateway.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpclink.apigatewayv2.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcpeeringconnection.ec2.aws.upbound.io":                                {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcpeeringconnectionaccepter.ec2.aws.upbound.io":                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpcpeeringconnectionoptions.ec2.aws.upbound.io":                         {},
+	"vpnconnection.ec2.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpnconnectionroute.ec2.aws.upbound.io":                                  {},
+	"vpngateway.ec2.aws.upbound.io":                                          {tagsField: "tags", tagsShape: tagShapeMap},
+	"vpngatewayattachment.ec2.aws.upbound.io":                                {},
+	"vpngatewayroutepropagation.ec2.aws.upbound.io":                          {},
+	"webacl.waf.aws.upbound.io":                                              {tagsField: "tags", tagsShape: tagShapeMap},
+	"webacl.wafregional.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"webhook.amplify.aws.upbound.io":                                         {},
+	"webhook.codepipeline.aws.upbound.io":                                    {tagsField: "tags", tagsShape: tagShapeMap},
+	"windowsfilesystem.fsx.aws.upbound.io":                                   {tagsField: "tags", tagsShape: tagShapeMap},
+	"workerconfiguration.kafkaconnect.aws.upbound.io":                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"workflow.glue.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"workflow.transfer.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"workforce.sagemaker.aws.upbound.io":                                     {},
+	"workgroup.athena.aws.upbound.io":                                        {tagsField: "tags", tagsShape: tagShapeMap},
+	"workgroup.redshiftserverless.aws.upbound.io":                            {tagsField: "tags", tagsShape: tagShapeMap},
+	"workspace.amp.aws.upbound.io":                                           {tagsField: "tags", tagsShape: tagShapeMap},
+	"workspace.grafana.aws.upbound.io":                                       {tagsField: "tags", tagsShape: tagShapeMap},
+	"workspaceapikey.grafana.aws.upbound.io":                                 {},
+	"workspacesamlconfiguration.grafana.aws.upbound.io":                      {},
+	"workteam.sagemaker.aws.upbound.io":                                      {tagsField: "tags", tagsShape: tagShapeMap},
+	"xssmatchset.waf.aws.upbound.io":                                         {},
+	"xssmatchset.wafregional.aws.upbound.io":                                 {},
+	"zone.route53.aws.upbound.io":                                            {tagsField: "tags", tagsShape: tagShapeMap, resourceType: "route53:hostedzone", cloudFormationType: "AWS::Route53::HostedZone", awsConfigType: "AWS::Route53::HostedZone", externalNameFromARN: regexp.MustCompile(`:hostedzone/(?P<name>[^/]+)$`)},
+	"zoneassociation.route53.aws.upbound.io":                                 {},
+}
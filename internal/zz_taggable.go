@@ -1,1006 +0,0 @@
-// this file has been autogenerated by "go generate". Do not edit it manually
-//
-//go:generate ../hack/generate_taggable_filter.sh
-package internal
-
-var taggableGroupKinds = map[string]bool{
-	"analyzer.accessanalyzer.aws.m.upbound.io":                             true,
-	"analyzer.accessanalyzer.aws.upbound.io":                               true,
-	"certificate.acm.aws.m.upbound.io":                                     true,
-	"certificate.acm.aws.upbound.io":                                       true,
-	"certificateauthority.acmpca.aws.m.upbound.io":                         true,
-	"certificateauthority.acmpca.aws.upbound.io":                           true,
-	"rulegroupnamespace.amp.aws.m.upbound.io":                              true,
-	"workspace.amp.aws.m.upbound.io":                                       true,
-	"rulegroupnamespace.amp.aws.upbound.io":                                true,
-	"workspace.amp.aws.upbound.io":                                         true,
-	"app.amplify.aws.m.upbound.io":                                         true,
-	"branch.amplify.aws.m.upbound.io":                                      true,
-	"app.amplify.aws.upbound.io":                                           true,
-	"branch.amplify.aws.upbound.io":                                        true,
-	"apikey.apigateway.aws.m.upbound.io":                                   true,
-	"clientcertificate.apigateway.aws.m.upbound.io":                        true,
-	"domainname.apigateway.aws.m.upbound.io":                               true,
-	"restapi.apigateway.aws.m.upbound.io":                                  true,
-	"stage.apigateway.aws.m.upbound.io":                                    true,
-	"usageplan.apigateway.aws.m.upbound.io":                                true,
-	"vpclink.apigateway.aws.m.upbound.io":                                  true,
-	"apikey.apigateway.aws.upbound.io":                                     true,
-	"clientcertificate.apigateway.aws.upbound.io":                          true,
-	"domainname.apigateway.aws.upbound.io":                                 true,
-	"restapi.apigateway.aws.upbound.io":                                    true,
-	"stage.apigateway.aws.upbound.io":                                      true,
-	"usageplan.apigateway.aws.upbound.io":                                  true,
-	"vpclink.apigateway.aws.upbound.io":                                    true,
-	"api.apigatewayv2.aws.m.upbound.io":                                    true,
-	"domainname.apigatewayv2.aws.m.upbound.io":                             true,
-	"stage.apigatewayv2.aws.m.upbound.io":                                  true,
-	"vpclink.apigatewayv2.aws.m.upbound.io":                                true,
-	"api.apigatewayv2.aws.upbound.io":                                      true,
-	"domainname.apigatewayv2.aws.upbound.io":                               true,
-	"stage.apigatewayv2.aws.upbound.io":                                    true,
-	"vpclink.apigatewayv2.aws.upbound.io":                                  true,
-	"target.appautoscaling.aws.m.upbound.io":                               true,
-	"target.appautoscaling.aws.upbound.io":                                 true,
-	"application.appconfig.aws.m.upbound.io":                               true,
-	"configurationprofile.appconfig.aws.m.upbound.io":                      true,
-	"deployment.appconfig.aws.m.upbound.io":                                true,
-	"deploymentstrategy.appconfig.aws.m.upbound.io":                        true,
-	"environment.appconfig.aws.m.upbound.io":                               true,
-	"extension.appconfig.aws.m.upbound.io":                                 true,
-	"application.appconfig.aws.upbound.io":                                 true,
-	"configurationprofile.appconfig.aws.upbound.io":                        true,
-	"deployment.appconfig.aws.upbound.io":                                  true,
-	"deploymentstrategy.appconfig.aws.upbound.io":                          true,
-	"environment.appconfig.aws.upbound.io":                                 true,
-	"extension.appconfig.aws.upbound.io":                                   true,
-	"flow.appflow.aws.m.upbound.io":                                        true,
-	"flow.appflow.aws.upbound.io":                                          true,
-	"eventintegration.appintegrations.aws.m.upbound.io":                    true,
-	"eventintegration.appintegrations.aws.upbound.io":                      true,
-	"application.applicationinsights.aws.m.upbound.io":                     true,
-	"application.applicationinsights.aws.upbound.io":                       true,
-	"gatewayroute.appmesh.aws.m.upbound.io":                                true,
-	"mesh.appmesh.aws.m.upbound.io":                                        true,
-	"route.appmesh.aws.m.upbound.io":                                       true,
-	"virtualgateway.appmesh.aws.m.upbound.io":                              true,
-	"virtualnode.appmesh.aws.m.upbound.io":                                 true,
-	"virtualrouter.appmesh.aws.m.upbound.io":                               true,
-	"virtualservice.appmesh.aws.m.upbound.io":                              true,
-	"gatewayroute.appmesh.aws.upbound.io":                                  true,
-	"mesh.appmesh.aws.upbound.io":                                          true,
-	"route.appmesh.aws.upbound.io":                                         true,
-	"virtualgateway.appmesh.aws.upbound.io":                                true,
-	"virtualnode.appmesh.aws.upbound.io":                                   true,
-	"virtualrouter.appmesh.aws.upbound.io":                                 true,
-	"virtualservice.appmesh.aws.upbound.io":                                true,
-	"autoscalingconfigurationversion.apprunner.aws.m.upbound.io":           true,
-	"connection.apprunner.aws.m.upbound.io":                                true,
-	"observabilityconfiguration.apprunner.aws.m.upbound.io":                true,
-	"service.apprunner.aws.m.upbound.io":                                   true,
-	"vpcconnector.apprunner.aws.m.upbound.io":                              true,
-	"autoscalingconfigurationversion.apprunner.aws.upbound.io":             true,
-	"connection.apprunner.aws.upbound.io":                                  true,
-	"observabilityconfiguration.apprunner.aws.upbound.io":                  true,
-	"service.apprunner.aws.upbound.io":                                     true,
-	"vpcconnector.apprunner.aws.upbound.io":                                true,
-	"fleet.appstream.aws.m.upbound.io":                                     true,
-	"imagebuilder.appstream.aws.m.upbound.io":                              true,
-	"stack.appstream.aws.m.upbound.io":                                     true,
-	"fleet.appstream.aws.upbound.io":                                       true,
-	"imagebuilder.appstream.aws.upbound.io":                                true,
-	"stack.appstream.aws.upbound.io":                                       true,
-	"graphqlapi.appsync.aws.m.upbound.io":                                  true,
-	"graphqlapi.appsync.aws.upbound.io":                                    true,
-	"datacatalog.athena.aws.m.upbound.io":                                  true,
-	"workgroup.athena.aws.m.upbound.io":                                    true,
-	"datacatalog.athena.aws.upbound.io":                                    true,
-	"workgroup.athena.aws.upbound.io":                                      true,
-	"autoscalinggroup.autoscaling.aws.upbound.io":                          true,
-	"framework.backup.aws.m.upbound.io":                                    true,
-	"plan.backup.aws.m.upbound.io":                                         true,
-	"reportplan.backup.aws.m.upbound.io":                                   true,
-	"vault.backup.aws.m.upbound.io":                                        true,
-	"framework.backup.aws.upbound.io":                                      true,
-	"plan.backup.aws.upbound.io":                                           true,
-	"reportplan.backup.aws.upbound.io":                                     true,
-	"vault.backup.aws.upbound.io":                                          true,
-	"computeenvironment.batch.aws.m.upbound.io":                            true,
-	"jobdefinition.batch.aws.m.upbound.io":                                 true,
-	"jobqueue.batch.aws.m.upbound.io":                                      true,
-	"schedulingpolicy.batch.aws.m.upbound.io":                              true,
-	"computeenvironment.batch.aws.upbound.io":                              true,
-	"jobdefinition.batch.aws.upbound.io":                                   true,
-	"jobqueue.batch.aws.upbound.io":                                        true,
-	"schedulingpolicy.batch.aws.upbound.io":                                true,
-	"inferenceprofile.bedrock.aws.m.upbound.io":                            true,
-	"inferenceprofile.bedrock.aws.upbound.io":                              true,
-	"agent.bedrockagent.aws.m.upbound.io":                                  true,
-	"agent.bedrockagent.aws.upbound.io":                                    true,
-	"budgetaction.budgets.aws.m.upbound.io":                                true,
-	"budget.budgets.aws.m.upbound.io":                                      true,
-	"budgetaction.budgets.aws.upbound.io":                                  true,
-	"budget.budgets.aws.upbound.io":                                        true,
-	"anomalymonitor.ce.aws.m.upbound.io":                                   true,
-	"anomalymonitor.ce.aws.upbound.io":                                     true,
-	"voiceconnector.chime.aws.m.upbound.io":                                true,
-	"voiceconnector.chime.aws.upbound.io":                                  true,
-	"environmentec2.cloud9.aws.m.upbound.io":                               true,
-	"environmentec2.cloud9.aws.upbound.io":                                 true,
-	"stack.cloudformation.aws.m.upbound.io":                                true,
-	"stackset.cloudformation.aws.m.upbound.io":                             true,
-	"stack.cloudformation.aws.upbound.io":                                  true,
-	"stackset.cloudformation.aws.upbound.io":                               true,
-	"distribution.cloudfront.aws.m.upbound.io":                             true,
-	"distribution.cloudfront.aws.upbound.io":                               true,
-	"eventdatastore.cloudtrail.aws.m.upbound.io":                           true,
-	"trail.cloudtrail.aws.m.upbound.io":                                    true,
-	"eventdatastore.cloudtrail.aws.upbound.io":                             true,
-	"trail.cloudtrail.aws.upbound.io":                                      true,
-	"compositealarm.cloudwatch.aws.m.upbound.io":                           true,
-	"metricalarm.cloudwatch.aws.m.upbound.io":                              true,
-	"metricstream.cloudwatch.aws.m.upbound.io":                             true,
-	"compositealarm.cloudwatch.aws.upbound.io":                             true,
-	"metricalarm.cloudwatch.aws.upbound.io":                                true,
-	"metricstream.cloudwatch.aws.upbound.io":                               true,
-	"bus.cloudwatchevents.aws.m.upbound.io":                                true,
-	"rule.cloudwatchevents.aws.m.upbound.io":                               true,
-	"bus.cloudwatchevents.aws.upbound.io":                                  true,
-	"rule.cloudwatchevents.aws.upbound.io":                                 true,
-	"destination.cloudwatchlogs.aws.m.upbound.io":                          true,
-	"group.cloudwatchlogs.aws.m.upbound.io":                                true,
-	"destination.cloudwatchlogs.aws.upbound.io":                            true,
-	"group.cloudwatchlogs.aws.upbound.io":                                  true,
-	"domain.codeartifact.aws.m.upbound.io":                                 true,
-	"repository.codeartifact.aws.m.upbound.io":                             true,
-	"domain.codeartifact.aws.upbound.io":                                   true,
-	"repository.codeartifact.aws.upbound.io":                               true,
-	"repository.codecommit.aws.m.upbound.io":                               true,
-	"repository.codecommit.aws.upbound.io":                                 true,
-	"profilinggroup.codeguruprofiler.aws.m.upbound.io":                     true,
-	"profilinggroup.codeguruprofiler.aws.upbound.io":                       true,
-	"codepipeline.codepipeline.aws.m.upbound.io":                           true,
-	"customactiontype.codepipeline.aws.m.upbound.io":                       true,
-	"webhook.codepipeline.aws.m.upbound.io":                                true,
-	"codepipeline.codepipeline.aws.upbound.io":                             true,
-	"customactiontype.codepipeline.aws.upbound.io":                         true,
-	"webhook.codepipeline.aws.upbound.io":                                  true,
-	"connection.codestarconnections.aws.m.upbound.io":                      true,
-	"connection.codestarconnections.aws.upbound.io":                        true,
-	"notificationrule.codestarnotifications.aws.m.upbound.io":              true,
-	"notificationrule.codestarnotifications.aws.upbound.io":                true,
-	"pool.cognitoidentity.aws.m.upbound.io":                                true,
-	"pool.cognitoidentity.aws.upbound.io":                                  true,
-	"userpool.cognitoidp.aws.m.upbound.io":                                 true,
-	"userpool.cognitoidp.aws.upbound.io":                                   true,
-	"configrule.configservice.aws.m.upbound.io":                            true,
-	"configurationaggregator.configservice.aws.m.upbound.io":               true,
-	"configrule.configservice.aws.upbound.io":                              true,
-	"configurationaggregator.configservice.aws.upbound.io":                 true,
-	"contactflowmodule.connect.aws.m.upbound.io":                           true,
-	"contactflow.connect.aws.m.upbound.io":                                 true,
-	"hoursofoperation.connect.aws.m.upbound.io":                            true,
-	"instance.connect.aws.m.upbound.io":                                    true,
-	"phonenumber.connect.aws.m.upbound.io":                                 true,
-	"queue.connect.aws.m.upbound.io":                                       true,
-	"quickconnect.connect.aws.m.upbound.io":                                true,
-	"routingprofile.connect.aws.m.upbound.io":                              true,
-	"securityprofile.connect.aws.m.upbound.io":                             true,
-	"user.connect.aws.m.upbound.io":                                        true,
-	"vocabulary.connect.aws.m.upbound.io":                                  true,
-	"contactflowmodule.connect.aws.upbound.io":                             true,
-	"contactflow.connect.aws.upbound.io":                                   true,
-	"hoursofoperation.connect.aws.upbound.io":                              true,
-	"instance.connect.aws.upbound.io":                                      true,
-	"phonenumber.connect.aws.upbound.io":                                   true,
-	"queue.connect.aws.upbound.io":                                         true,
-	"quickconnect.connect.aws.upbound.io":                                  true,
-	"routingprofile.connect.aws.upbound.io":                                true,
-	"securityprofile.connect.aws.upbound.io":                               true,
-	"user.connect.aws.upbound.io":                                          true,
-	"vocabulary.connect.aws.upbound.io":                                    true,
-	"reportdefinition.cur.aws.m.upbound.io":                                true,
-	"reportdefinition.cur.aws.upbound.io":                                  true,
-	"dataset.dataexchange.aws.m.upbound.io":                                true,
-	"revision.dataexchange.aws.m.upbound.io":                               true,
-	"dataset.dataexchange.aws.upbound.io":                                  true,
-	"revision.dataexchange.aws.upbound.io":                                 true,
-	"pipeline.datapipeline.aws.m.upbound.io":                               true,
-	"pipeline.datapipeline.aws.upbound.io":                                 true,
-	"locations3.datasync.aws.m.upbound.io":                                 true,
-	"task.datasync.aws.m.upbound.io":                                       true,
-	"locations3.datasync.aws.upbound.io":                                   true,
-	"task.datasync.aws.upbound.io":                                         true,
-	"cluster.dax.aws.m.upbound.io":                                         true,
-	"cluster.dax.aws.upbound.io":                                           true,
-	"app.deploy.aws.m.upbound.io":                                          true,
-	"deploymentgroup.deploy.aws.m.upbound.io":                              true,
-	"app.deploy.aws.upbound.io":                                            true,
-	"deploymentgroup.deploy.aws.upbound.io":                                true,
-	"graph.detective.aws.m.upbound.io":                                     true,
-	"graph.detective.aws.upbound.io":                                       true,
-	"devicepool.devicefarm.aws.m.upbound.io":                               true,
-	"instanceprofile.devicefarm.aws.m.upbound.io":                          true,
-	"networkprofile.devicefarm.aws.m.upbound.io":                           true,
-	"project.devicefarm.aws.m.upbound.io":                                  true,
-	"testgridproject.devicefarm.aws.m.upbound.io":                          true,
-	"devicepool.devicefarm.aws.upbound.io":                                 true,
-	"instanceprofile.devicefarm.aws.upbound.io":                            true,
-	"networkprofile.devicefarm.aws.upbound.io":                             true,
-	"project.devicefarm.aws.upbound.io":                                    true,
-	"testgridproject.devicefarm.aws.upbound.io":                            true,
-	"connection.directconnect.aws.m.upbound.io":                            true,
-	"hostedprivatevirtualinterfaceaccepter.directconnect.aws.m.upbound.io": true,
-	"hostedpublicvirtualinterfaceaccepter.directconnect.aws.m.upbound.io":  true,
-	"hostedtransitvirtualinterfaceaccepter.directconnect.aws.m.upbound.io": true,
-	"lag.directconnect.aws.m.upbound.io":                                   true,
-	"privatevirtualinterface.directconnect.aws.m.upbound.io":               true,
-	"publicvirtualinterface.directconnect.aws.m.upbound.io":                true,
-	"transitvirtualinterface.directconnect.aws.m.upbound.io":               true,
-	"connection.directconnect.aws.upbound.io":                              true,
-	"hostedprivatevirtualinterfaceaccepter.directconnect.aws.upbound.io":   true,
-	"hostedpublicvirtualinterfaceaccepter.directconnect.aws.upbound.io":    true,
-	"hostedtransitvirtualinterfaceaccepter.directconnect.aws.upbound.io":   true,
-	"lag.directconnect.aws.upbound.io":                                     true,
-	"privatevirtualinterface.directconnect.aws.upbound.io":                 true,
-	"publicvirtualinterface.directconnect.aws.upbound.io":                  true,
-	"transitvirtualinterface.directconnect.aws.upbound.io":                 true,
-	"lifecyclepolicy.dlm.aws.m.upbound.io":                                 true,
-	"lifecyclepolicy.dlm.aws.upbound.io":                                   true,
-	"certificate.dms.aws.m.upbound.io":                                     true,
-	"endpoint.dms.aws.m.upbound.io":                                        true,
-	"eventsubscription.dms.aws.m.upbound.io":                               true,
-	"replicationinstance.dms.aws.m.upbound.io":                             true,
-	"replicationsubnetgroup.dms.aws.m.upbound.io":                          true,
-	"replicationtask.dms.aws.m.upbound.io":                                 true,
-	"s3endpoint.dms.aws.m.upbound.io":                                      true,
-	"certificate.dms.aws.upbound.io":                                       true,
-	"endpoint.dms.aws.upbound.io":                                          true,
-	"eventsubscription.dms.aws.upbound.io":                                 true,
-	"replicationinstance.dms.aws.upbound.io":                               true,
-	"replicationsubnetgroup.dms.aws.upbound.io":                            true,
-	"replicationtask.dms.aws.upbound.io":                                   true,
-	"s3endpoint.dms.aws.upbound.io":                                        true,
-	"clusterinstance.docdb.aws.m.upbound.io":                               true,
-	"clusterparametergroup.docdb.aws.m.upbound.io":                         true,
-	"cluster.docdb.aws.m.upbound.io":                                       true,
-	"eventsubscription.docdb.aws.m.upbound.io":                             true,
-	"subnetgroup.docdb.aws.m.upbound.io":                                   true,
-	"clusterinstance.docdb.aws.upbound.io":                                 true,
-	"clusterparametergroup.docdb.aws.upbound.io":                           true,
-	"cluster.docdb.aws.upbound.io":                                         true,
-	"eventsubscription.docdb.aws.upbound.io":                               true,
-	"subnetgroup.docdb.aws.upbound.io":                                     true,
-	"directory.ds.aws.m.upbound.io":                                        true,
-	"directory.ds.aws.upbound.io":                                          true,
-	"cluster.dsql.aws.m.upbound.io":                                        true,
-	"cluster.dsql.aws.upbound.io":                                          true,
-	"tablereplica.dynamodb.aws.m.upbound.io":                               true,
-	"table.dynamodb.aws.m.upbound.io":                                      true,
-	"tablereplica.dynamodb.aws.upbound.io":                                 true,
-	"table.dynamodb.aws.upbound.io":                                        true,
-	"amicopy.ec2.aws.m.upbound.io":                                         true,
-	"ami.ec2.aws.m.upbound.io":                                             true,
-	"capacityreservation.ec2.aws.m.upbound.io":                             true,
-	"carriergateway.ec2.aws.m.upbound.io":                                  true,
-	"customergateway.ec2.aws.m.upbound.io":                                 true,
-	"defaultnetworkacl.ec2.aws.m.upbound.io":                               true,
-	"defaultroutetable.ec2.aws.m.upbound.io":                               true,
-	"defaultsecuritygroup.ec2.aws.m.upbound.io":                            true,
-	"defaultsubnet.ec2.aws.m.upbound.io":                                   true,
-	"defaultvpcdhcpoptions.ec2.aws.m.upbound.io":                           true,
-	"defaultvpc.ec2.aws.m.upbound.io":                                      true,
-	"ebssnapshotcopy.ec2.aws.m.upbound.io":                                 true,
-	"ebssnapshotimport.ec2.aws.m.upbound.io":                               true,
-	"ebssnapshot.ec2.aws.m.upbound.io":                                     true,
-	"ebsvolume.ec2.aws.m.upbound.io":                                       true,
-	"egressonlyinternetgateway.ec2.aws.m.upbound.io":                       true,
-	"eip.ec2.aws.m.upbound.io":                                             true,
-	"fleet.ec2.aws.m.upbound.io":                                           true,
-	"flowlog.ec2.aws.m.upbound.io":                                         true,
-	"host.ec2.aws.m.upbound.io":                                            true,
-	"instance.ec2.aws.m.upbound.io":                                        true,
-	"internetgateway.ec2.aws.m.upbound.io":                                 true,
-	"keypair.ec2.aws.m.upbound.io":                                         true,
-	"launchtemplate.ec2.aws.m.upbound.io":                                  true,
-	"managedprefixlist.ec2.aws.m.upbound.io":                               true,
-	"natgateway.ec2.aws.m.upbound.io":                                      true,
-	"networkacl.ec2.aws.m.upbound.io":                                      true,
-	"networkinsightsanalysis.ec2.aws.m.upbound.io":                         true,
-	"networkinsightspath.ec2.aws.m.upbound.io":                             true,
-	"networkinterface.ec2.aws.m.upbound.io":                                true,
-	"placementgroup.ec2.aws.m.upbound.io":                                  true,
-	"routetable.ec2.aws.m.upbound.io":                                      true,
-	"securitygroupegressrule.ec2.aws.m.upbound.io":                         true,
-	"securitygroupingressrule.ec2.aws.m.upbound.io":                        true,
-	"securitygroup.ec2.aws.m.upbound.io":                                   true,
-	"spotfleetrequest.ec2.aws.m.upbound.io":                                true,
-	"spotinstancerequest.ec2.aws.m.upbound.io":                             true,
-	"subnet.ec2.aws.m.upbound.io":                                          true,
-	"trafficmirrorfilter.ec2.aws.m.upbound.io":                             true,
-	"transitgatewayconnectpeer.ec2.aws.m.upbound.io":                       true,
-	"transitgatewayconnect.ec2.aws.m.upbound.io":                           true,
-	"transitgatewaymulticastdomain.ec2.aws.m.upbound.io":                   true,
-	"transitgatewaypeeringattachmentaccepter.ec2.aws.m.upbound.io":         true,
-	"transitgatewaypeeringattachment.ec2.aws.m.upbound.io":                 true,
-	"transitgatewaypolicytable.ec2.aws.m.upbound.io":                       true,
-	"transitgatewayroutetable.ec2.aws.m.upbound.io":                        true,
-	"transitgateway.ec2.aws.m.upbound.io":                                  true,
-	"transitgatewayvpcattachmentaccepter.ec2.aws.m.upbound.io":             true,
-	"transitgatewayvpcattachment.ec2.aws.m.upbound.io":                     true,
-	"vpcdhcpoptions.ec2.aws.m.upbound.io":                                  true,
-	"vpcendpoint.ec2.aws.m.upbound.io":                                     true,
-	"vpcendpointservice.ec2.aws.m.upbound.io":                              true,
-	"vpcipampool.ec2.aws.m.upbound.io":                                     true,
-	"vpcipam.ec2.aws.m.upbound.io":                                         true,
-	"vpcipamscope.ec2.aws.m.upbound.io":                                    true,
-	"vpcpeeringconnectionaccepter.ec2.aws.m.upbound.io":                    true,
-	"vpcpeeringconnection.ec2.aws.m.upbound.io":                            true,
-	"vpc.ec2.aws.m.upbound.io":                                             true,
-	"vpnconnection.ec2.aws.m.upbound.io":                                   true,
-	"vpngateway.ec2.aws.m.upbound.io":                                      true,
-	"amicopy.ec2.aws.upbound.io":                                           true,
-	"ami.ec2.aws.upbound.io":                                               true,
-	"capacityreservation.ec2.aws.upbound.io":                               true,
-	"carriergateway.ec2.aws.upbound.io":                                    true,
-	"customergateway.ec2.aws.upbound.io":                                   true,
-	"defaultnetworkacl.ec2.aws.upbound.io":                                 true,
-	"defaultroutetable.ec2.aws.upbound.io":                                 true,
-	"defaultsecuritygroup.ec2.aws.upbound.io":                              true,
-	"defaultsubnet.ec2.aws.upbound.io":                                     true,
-	"defaultvpcdhcpoptions.ec2.aws.upbound.io":                             true,
-	"defaultvpc.ec2.aws.upbound.io":                                        true,
-	"ebssnapshotcopy.ec2.aws.upbound.io":                                   true,
-	"ebssnapshotimport.ec2.aws.upbound.io":                                 true,
-	"ebssnapshot.ec2.aws.upbound.io":                                       true,
-	"ebsvolume.ec2.aws.upbound.io":                                         true,
-	"egressonlyinternetgateway.ec2.aws.upbound.io":                         true,
-	"eip.ec2.aws.upbound.io":                                               true,
-	"fleet.ec2.aws.upbound.io":                                             true,
-	"flowlog.ec2.aws.upbound.io":                                           true,
-	"host.ec2.aws.upbound.io":                                              true,
-	"instance.ec2.aws.upbound.io":                                          true,
-	"internetgateway.ec2.aws.upbound.io":                                   true,
-	"keypair.ec2.aws.upbound.io":                                           true,
-	"launchtemplate.ec2.aws.upbound.io":                                    true,
-	"managedprefixlist.ec2.aws.upbound.io":                                 true,
-	"natgateway.ec2.aws.upbound.io":                                        true,
-	"networkacl.ec2.aws.upbound.io":                                        true,
-	"networkinsightsanalysis.ec2.aws.upbound.io":                           true,
-	"networkinsightspath.ec2.aws.upbound.io":                               true,
-	"networkinterface.ec2.aws.upbound.io":                                  true,
-	"placementgroup.ec2.aws.upbound.io":                                    true,
-	"routetable.ec2.aws.upbound.io":                                        true,
-	"securitygroupegressrule.ec2.aws.upbound.io":                           true,
-	"securitygroupingressrule.ec2.aws.upbound.io":                          true,
-	"securitygroup.ec2.aws.upbound.io":                                     true,
-	"spotfleetrequest.ec2.aws.upbound.io":                                  true,
-	"spotinstancerequest.ec2.aws.upbound.io":                               true,
-	"subnet.ec2.aws.upbound.io":                                            true,
-	"trafficmirrorfilter.ec2.aws.upbound.io":                               true,
-	"transitgatewayconnectpeer.ec2.aws.upbound.io":                         true,
-	"transitgatewayconnect.ec2.aws.upbound.io":                             true,
-	"transitgatewaymulticastdomain.ec2.aws.upbound.io":                     true,
-	"transitgatewaypeeringattachmentaccepter.ec2.aws.upbound.io":           true,
-	"transitgatewaypeeringattachment.ec2.aws.upbound.io":                   true,
-	"transitgatewaypolicytable.ec2.aws.upbound.io":                         true,
-	"transitgatewayroutetable.ec2.aws.upbound.io":                          true,
-	"transitgateway.ec2.aws.upbound.io":                                    true,
-	"transitgatewayvpcattachmentaccepter.ec2.aws.upbound.io":               true,
-	"transitgatewayvpcattachment.ec2.aws.upbound.io":                       true,
-	"vpcdhcpoptions.ec2.aws.upbound.io":                                    true,
-	"vpcendpoint.ec2.aws.upbound.io":                                       true,
-	"vpcendpointservice.ec2.aws.upbound.io":                                true,
-	"vpcipampool.ec2.aws.upbound.io":                                       true,
-	"vpcipam.ec2.aws.upbound.io":                                           true,
-	"vpcipamscope.ec2.aws.upbound.io":                                      true,
-	"vpcpeeringconnectionaccepter.ec2.aws.upbound.io":                      true,
-	"vpcpeeringconnection.ec2.aws.upbound.io":                              true,
-	"vpc.ec2.aws.upbound.io":                                               true,
-	"vpnconnection.ec2.aws.upbound.io":                                     true,
-	"vpngateway.ec2.aws.upbound.io":                                        true,
-	"repository.ecr.aws.m.upbound.io":                                      true,
-	"repository.ecr.aws.upbound.io":                                        true,
-	"repository.ecrpublic.aws.m.upbound.io":                                true,
-	"repository.ecrpublic.aws.upbound.io":                                  true,
-	"capacityprovider.ecs.aws.m.upbound.io":                                true,
-	"cluster.ecs.aws.m.upbound.io":                                         true,
-	"service.ecs.aws.m.upbound.io":                                         true,
-	"taskdefinition.ecs.aws.m.upbound.io":                                  true,
-	"capacityprovider.ecs.aws.upbound.io":                                  true,
-	"cluster.ecs.aws.upbound.io":                                           true,
-	"service.ecs.aws.upbound.io":                                           true,
-	"taskdefinition.ecs.aws.upbound.io":                                    true,
-	"accesspoint.efs.aws.m.upbound.io":                                     true,
-	"filesystem.efs.aws.m.upbound.io":                                      true,
-	"accesspoint.efs.aws.upbound.io":                                       true,
-	"filesystem.efs.aws.upbound.io":                                        true,
-	"accessentry.eks.aws.m.upbound.io":                                     true,
-	"addon.eks.aws.m.upbound.io":                                           true,
-	"cluster.eks.aws.m.upbound.io":                                         true,
-	"fargateprofile.eks.aws.m.upbound.io":                                  true,
-	"identityproviderconfig.eks.aws.m.upbound.io":                          true,
-	"nodegroup.eks.aws.m.upbound.io":                                       true,
-	"podidentityassociation.eks.aws.m.upbound.io":                          true,
-	"accessentry.eks.aws.upbound.io":                                       true,
-	"addon.eks.aws.upbound.io":                                             true,
-	"cluster.eks.aws.upbound.io":                                           true,
-	"fargateprofile.eks.aws.upbound.io":                                    true,
-	"identityproviderconfig.eks.aws.upbound.io":                            true,
-	"nodegroup.eks.aws.upbound.io":                                         true,
-	"podidentityassociation.eks.aws.upbound.io":                            true,
-	"cluster.elasticache.aws.m.upbound.io":                                 true,
-	"parametergroup.elasticache.aws.m.upbound.io":                          true,
-	"replicationgroup.elasticache.aws.m.upbound.io":                        true,
-	"serverlesscache.elasticache.aws.m.upbound.io":                         true,
-	"subnetgroup.elasticache.aws.m.upbound.io":                             true,
-	"usergroup.elasticache.aws.m.upbound.io":                               true,
-	"user.elasticache.aws.m.upbound.io":                                    true,
-	"cluster.elasticache.aws.upbound.io":                                   true,
-	"parametergroup.elasticache.aws.upbound.io":                            true,
-	"replicationgroup.elasticache.aws.upbound.io":                          true,
-	"serverlesscache.elasticache.aws.upbound.io":                           true,
-	"subnetgroup.elasticache.aws.upbound.io":                               true,
-	"usergroup.elasticache.aws.upbound.io":                                 true,
-	"user.elasticache.aws.upbound.io":                                      true,
-	"application.elasticbeanstalk.aws.m.upbound.io":                        true,
-	"applicationversion.elasticbeanstalk.aws.m.upbound.io":                 true,
-	"application.elasticbeanstalk.aws.upbound.io":                          true,
-	"applicationversion.elasticbeanstalk.aws.upbound.io":                   true,
-	"domain.elasticsearch.aws.m.upbound.io":                                true,
-	"domain.elasticsearch.aws.upbound.io":                                  true,
-	"elb.elb.aws.m.upbound.io":                                             true,
-	"elb.elb.aws.upbound.io":                                               true,
-	"lblistenerrule.elbv2.aws.m.upbound.io":                                true,
-	"lblistener.elbv2.aws.m.upbound.io":                                    true,
-	"lb.elbv2.aws.m.upbound.io":                                            true,
-	"lbtargetgroup.elbv2.aws.m.upbound.io":                                 true,
-	"lbtruststore.elbv2.aws.m.upbound.io":                                  true,
-	"lblistenerrule.elbv2.aws.upbound.io":                                  true,
-	"lblistener.elbv2.aws.upbound.io":                                      true,
-	"lb.elbv2.aws.upbound.io":                                              true,
-	"lbtargetgroup.elbv2.aws.upbound.io":                                   true,
-	"lbtruststore.elbv2.aws.upbound.io":                                    true,
-	"application.emrserverless.aws.m.upbound.io":                           true,
-	"application.emrserverless.aws.upbound.io":                             true,
-	"feature.evidently.aws.m.upbound.io":                                   true,
-	"project.evidently.aws.m.upbound.io":                                   true,
-	"segment.evidently.aws.m.upbound.io":                                   true,
-	"feature.evidently.aws.upbound.io":                                     true,
-	"project.evidently.aws.upbound.io":                                     true,
-	"segment.evidently.aws.upbound.io":                                     true,
-	"deliverystream.firehose.aws.m.upbound.io":                             true,
-	"deliverystream.firehose.aws.upbound.io":                               true,
-	"experimenttemplate.fis.aws.m.upbound.io":                              true,
-	"experimenttemplate.fis.aws.upbound.io":                                true,
-	"backup.fsx.aws.m.upbound.io":                                          true,
-	"datarepositoryassociation.fsx.aws.m.upbound.io":                       true,
-	"lustrefilesystem.fsx.aws.m.upbound.io":                                true,
-	"ontapfilesystem.fsx.aws.m.upbound.io":                                 true,
-	"ontapstoragevirtualmachine.fsx.aws.m.upbound.io":                      true,
-	"windowsfilesystem.fsx.aws.m.upbound.io":                               true,
-	"backup.fsx.aws.upbound.io":                                            true,
-	"datarepositoryassociation.fsx.aws.upbound.io":                         true,
-	"lustrefilesystem.fsx.aws.upbound.io":                                  true,
-	"ontapfilesystem.fsx.aws.upbound.io":                                   true,
-	"ontapstoragevirtualmachine.fsx.aws.upbound.io":                        true,
-	"windowsfilesystem.fsx.aws.upbound.io":                                 true,
-	"alias.gamelift.aws.m.upbound.io":                                      true,
-	"build.gamelift.aws.m.upbound.io":                                      true,
-	"fleet.gamelift.aws.m.upbound.io":                                      true,
-	"gamesessionqueue.gamelift.aws.m.upbound.io":                           true,
-	"script.gamelift.aws.m.upbound.io":                                     true,
-	"alias.gamelift.aws.upbound.io":                                        true,
-	"build.gamelift.aws.upbound.io":                                        true,
-	"fleet.gamelift.aws.upbound.io":                                        true,
-	"gamesessionqueue.gamelift.aws.upbound.io":                             true,
-	"script.gamelift.aws.upbound.io":                                       true,
-	"vault.glacier.aws.m.upbound.io":                                       true,
-	"vault.glacier.aws.upbound.io":                                         true,
-	"accelerator.globalaccelerator.aws.m.upbound.io":                       true,
-	"accelerator.globalaccelerator.aws.upbound.io":                         true,
-	"catalogdatabase.glue.aws.m.upbound.io":                                true,
-	"connection.glue.aws.m.upbound.io":                                     true,
-	"crawler.glue.aws.m.upbound.io":                                        true,
-	"job.glue.aws.m.upbound.io":                                            true,
-	"registry.glue.aws.m.upbound.io":                                       true,
-	"schema.glue.aws.m.upbound.io":                                         true,
-	"trigger.glue.aws.m.upbound.io":                                        true,
-	"workflow.glue.aws.m.upbound.io":                                       true,
-	"catalogdatabase.glue.aws.upbound.io":                                  true,
-	"connection.glue.aws.upbound.io":                                       true,
-	"crawler.glue.aws.upbound.io":                                          true,
-	"job.glue.aws.upbound.io":                                              true,
-	"registry.glue.aws.upbound.io":                                         true,
-	"schema.glue.aws.upbound.io":                                           true,
-	"trigger.glue.aws.upbound.io":                                          true,
-	"workflow.glue.aws.upbound.io":                                         true,
-	"workspace.grafana.aws.m.upbound.io":                                   true,
-	"workspace.grafana.aws.upbound.io":                                     true,
-	"detector.guardduty.aws.m.upbound.io":                                  true,
-	"filter.guardduty.aws.m.upbound.io":                                    true,
-	"detector.guardduty.aws.upbound.io":                                    true,
-	"filter.guardduty.aws.upbound.io":                                      true,
-	"instanceprofile.iam.aws.m.upbound.io":                                 true,
-	"openidconnectprovider.iam.aws.m.upbound.io":                           true,
-	"policy.iam.aws.m.upbound.io":                                          true,
-	"role.iam.aws.m.upbound.io":                                            true,
-	"samlprovider.iam.aws.m.upbound.io":                                    true,
-	"servercertificate.iam.aws.m.upbound.io":                               true,
-	"servicelinkedrole.iam.aws.m.upbound.io":                               true,
-	"user.iam.aws.m.upbound.io":                                            true,
-	"virtualmfadevice.iam.aws.m.upbound.io":                                true,
-	"instanceprofile.iam.aws.upbound.io":                                   true,
-	"openidconnectprovider.iam.aws.upbound.io":                             true,
-	"policy.iam.aws.upbound.io":                                            true,
-	"role.iam.aws.upbound.io":                                              true,
-	"samlprovider.iam.aws.upbound.io":                                      true,
-	"servercertificate.iam.aws.upbound.io":                                 true,
-	"servicelinkedrole.iam.aws.upbound.io":                                 true,
-	"user.iam.aws.upbound.io":                                              true,
-	"virtualmfadevice.iam.aws.upbound.io":                                  true,
-	"component.imagebuilder.aws.m.upbound.io":                              true,
-	"containerrecipe.imagebuilder.aws.m.upbound.io":                        true,
-	"distributionconfiguration.imagebuilder.aws.m.upbound.io":              true,
-	"imagepipeline.imagebuilder.aws.m.upbound.io":                          true,
-	"imagerecipe.imagebuilder.aws.m.upbound.io":                            true,
-	"image.imagebuilder.aws.m.upbound.io":                                  true,
-	"infrastructureconfiguration.imagebuilder.aws.m.upbound.io":            true,
-	"component.imagebuilder.aws.upbound.io":                                true,
-	"containerrecipe.imagebuilder.aws.upbound.io":                          true,
-	"distributionconfiguration.imagebuilder.aws.upbound.io":                true,
-	"imagepipeline.imagebuilder.aws.upbound.io":                            true,
-	"imagerecipe.imagebuilder.aws.upbound.io":                              true,
-	"image.imagebuilder.aws.upbound.io":                                    true,
-	"infrastructureconfiguration.imagebuilder.aws.upbound.io":              true,
-	"assessmenttemplate.inspector.aws.m.upbound.io":                        true,
-	"resourcegroup.inspector.aws.m.upbound.io":                             true,
-	"assessmenttemplate.inspector.aws.upbound.io":                          true,
-	"resourcegroup.inspector.aws.upbound.io":                               true,
-	"authorizer.iot.aws.m.upbound.io":                                      true,
-	"domainconfiguration.iot.aws.m.upbound.io":                             true,
-	"policy.iot.aws.m.upbound.io":                                          true,
-	"provisioningtemplate.iot.aws.m.upbound.io":                            true,
-	"rolealias.iot.aws.m.upbound.io":                                       true,
-	"thinggroup.iot.aws.m.upbound.io":                                      true,
-	"thingtype.iot.aws.m.upbound.io":                                       true,
-	"topicrule.iot.aws.m.upbound.io":                                       true,
-	"authorizer.iot.aws.upbound.io":                                        true,
-	"domainconfiguration.iot.aws.upbound.io":                               true,
-	"policy.iot.aws.upbound.io":                                            true,
-	"provisioningtemplate.iot.aws.upbound.io":                              true,
-	"rolealias.iot.aws.upbound.io":                                         true,
-	"thinggroup.iot.aws.upbound.io":                                        true,
-	"thingtype.iot.aws.upbound.io":                                         true,
-	"topicrule.iot.aws.upbound.io":                                         true,
-	"channel.ivs.aws.m.upbound.io":                                         true,
-	"recordingconfiguration.ivs.aws.m.upbound.io":                          true,
-	"channel.ivs.aws.upbound.io":                                           true,
-	"recordingconfiguration.ivs.aws.upbound.io":                            true,
-	"cluster.kafka.aws.m.upbound.io":                                       true,
-	"replicator.kafka.aws.m.upbound.io":                                    true,
-	"serverlesscluster.kafka.aws.m.upbound.io":                             true,
-	"cluster.kafka.aws.upbound.io":                                         true,
-	"replicator.kafka.aws.upbound.io":                                      true,
-	"serverlesscluster.kafka.aws.upbound.io":                               true,
-	"connector.kafkaconnect.aws.m.upbound.io":                              true,
-	"customplugin.kafkaconnect.aws.m.upbound.io":                           true,
-	"workerconfiguration.kafkaconnect.aws.m.upbound.io":                    true,
-	"connector.kafkaconnect.aws.upbound.io":                                true,
-	"customplugin.kafkaconnect.aws.upbound.io":                             true,
-	"workerconfiguration.kafkaconnect.aws.upbound.io":                      true,
-	"datasource.kendra.aws.m.upbound.io":                                   true,
-	"index.kendra.aws.m.upbound.io":                                        true,
-	"querysuggestionsblocklist.kendra.aws.m.upbound.io":                    true,
-	"thesaurus.kendra.aws.m.upbound.io":                                    true,
-	"datasource.kendra.aws.upbound.io":                                     true,
-	"index.kendra.aws.upbound.io":                                          true,
-	"querysuggestionsblocklist.kendra.aws.upbound.io":                      true,
-	"thesaurus.kendra.aws.upbound.io":                                      true,
-	"keyspace.keyspaces.aws.m.upbound.io":                                  true,
-	"table.keyspaces.aws.m.upbound.io":                                     true,
-	"keyspace.keyspaces.aws.upbound.io":                                    true,
-	"table.keyspaces.aws.upbound.io":                                       true,
-	"streamconsumer.kinesis.aws.m.upbound.io":                              true,
-	"stream.kinesis.aws.m.upbound.io":                                      true,
-	"streamconsumer.kinesis.aws.upbound.io":                                true,
-	"stream.kinesis.aws.upbound.io":                                        true,
-	"application.kinesisanalytics.aws.m.upbound.io":                        true,
-	"application.kinesisanalytics.aws.upbound.io":                          true,
-	"application.kinesisanalyticsv2.aws.m.upbound.io":                      true,
-	"application.kinesisanalyticsv2.aws.upbound.io":                        true,
-	"stream.kinesisvideo.aws.m.upbound.io":                                 true,
-	"stream.kinesisvideo.aws.upbound.io":                                   true,
-	"externalkey.kms.aws.m.upbound.io":                                     true,
-	"key.kms.aws.m.upbound.io":                                             true,
-	"replicaexternalkey.kms.aws.m.upbound.io":                              true,
-	"replicakey.kms.aws.m.upbound.io":                                      true,
-	"externalkey.kms.aws.upbound.io":                                       true,
-	"key.kms.aws.upbound.io":                                               true,
-	"replicaexternalkey.kms.aws.upbound.io":                                true,
-	"replicakey.kms.aws.upbound.io":                                        true,
-	"codesigningconfig.lambda.aws.m.upbound.io":                            true,
-	"eventsourcemapping.lambda.aws.m.upbound.io":                           true,
-	"function.lambda.aws.m.upbound.io":                                     true,
-	"codesigningconfig.lambda.aws.upbound.io":                              true,
-	"eventsourcemapping.lambda.aws.upbound.io":                             true,
-	"function.lambda.aws.upbound.io":                                       true,
-	"licenseconfiguration.licensemanager.aws.m.upbound.io":                 true,
-	"licenseconfiguration.licensemanager.aws.upbound.io":                   true,
-	"bucket.lightsail.aws.m.upbound.io":                                    true,
-	"certificate.lightsail.aws.m.upbound.io":                               true,
-	"containerservice.lightsail.aws.m.upbound.io":                          true,
-	"disk.lightsail.aws.m.upbound.io":                                      true,
-	"instance.lightsail.aws.m.upbound.io":                                  true,
-	"keypair.lightsail.aws.m.upbound.io":                                   true,
-	"lb.lightsail.aws.m.upbound.io":                                        true,
-	"bucket.lightsail.aws.upbound.io":                                      true,
-	"certificate.lightsail.aws.upbound.io":                                 true,
-	"containerservice.lightsail.aws.upbound.io":                            true,
-	"disk.lightsail.aws.upbound.io":                                        true,
-	"instance.lightsail.aws.upbound.io":                                    true,
-	"keypair.lightsail.aws.upbound.io":                                     true,
-	"lb.lightsail.aws.upbound.io":                                          true,
-	"geofencecollection.location.aws.m.upbound.io":                         true,
-	"placeindex.location.aws.m.upbound.io":                                 true,
-	"routecalculator.location.aws.m.upbound.io":                            true,
-	"tracker.location.aws.m.upbound.io":                                    true,
-	"geofencecollection.location.aws.upbound.io":                           true,
-	"placeindex.location.aws.upbound.io":                                   true,
-	"routecalculator.location.aws.upbound.io":                              true,
-	"tracker.location.aws.upbound.io":                                      true,
-	"classificationjob.macie2.aws.m.upbound.io":                            true,
-	"customdataidentifier.macie2.aws.m.upbound.io":                         true,
-	"findingsfilter.macie2.aws.m.upbound.io":                               true,
-	"member.macie2.aws.m.upbound.io":                                       true,
-	"classificationjob.macie2.aws.upbound.io":                              true,
-	"customdataidentifier.macie2.aws.upbound.io":                           true,
-	"findingsfilter.macie2.aws.upbound.io":                                 true,
-	"member.macie2.aws.upbound.io":                                         true,
-	"queue.mediaconvert.aws.m.upbound.io":                                  true,
-	"queue.mediaconvert.aws.upbound.io":                                    true,
-	"channel.medialive.aws.m.upbound.io":                                   true,
-	"input.medialive.aws.m.upbound.io":                                     true,
-	"inputsecuritygroup.medialive.aws.m.upbound.io":                        true,
-	"multiplex.medialive.aws.m.upbound.io":                                 true,
-	"channel.medialive.aws.upbound.io":                                     true,
-	"input.medialive.aws.upbound.io":                                       true,
-	"inputsecuritygroup.medialive.aws.upbound.io":                          true,
-	"multiplex.medialive.aws.upbound.io":                                   true,
-	"channel.mediapackage.aws.m.upbound.io":                                true,
-	"channel.mediapackage.aws.upbound.io":                                  true,
-	"container.mediastore.aws.m.upbound.io":                                true,
-	"container.mediastore.aws.upbound.io":                                  true,
-	"acl.memorydb.aws.m.upbound.io":                                        true,
-	"cluster.memorydb.aws.m.upbound.io":                                    true,
-	"parametergroup.memorydb.aws.m.upbound.io":                             true,
-	"snapshot.memorydb.aws.m.upbound.io":                                   true,
-	"subnetgroup.memorydb.aws.m.upbound.io":                                true,
-	"user.memorydb.aws.m.upbound.io":                                       true,
-	"acl.memorydb.aws.upbound.io":                                          true,
-	"cluster.memorydb.aws.upbound.io":                                      true,
-	"parametergroup.memorydb.aws.upbound.io":                               true,
-	"snapshot.memorydb.aws.upbound.io":                                     true,
-	"subnetgroup.memorydb.aws.upbound.io":                                  true,
-	"user.memorydb.aws.upbound.io":                                         true,
-	"broker.mq.aws.m.upbound.io":                                           true,
-	"configuration.mq.aws.m.upbound.io":                                    true,
-	"broker.mq.aws.upbound.io":                                             true,
-	"configuration.mq.aws.upbound.io":                                      true,
-	"environment.mwaa.aws.m.upbound.io":                                    true,
-	"environment.mwaa.aws.upbound.io":                                      true,
-	"clusterendpoint.neptune.aws.m.upbound.io":                             true,
-	"clusterinstance.neptune.aws.m.upbound.io":                             true,
-	"clusterparametergroup.neptune.aws.m.upbound.io":                       true,
-	"cluster.neptune.aws.m.upbound.io":                                     true,
-	"eventsubscription.neptune.aws.m.upbound.io":                           true,
-	"parametergroup.neptune.aws.m.upbound.io":                              true,
-	"subnetgroup.neptune.aws.m.upbound.io":                                 true,
-	"clusterendpoint.neptune.aws.upbound.io":                               true,
-	"clusterinstance.neptune.aws.upbound.io":                               true,
-	"clusterparametergroup.neptune.aws.upbound.io":                         true,
-	"cluster.neptune.aws.upbound.io":                                       true,
-	"eventsubscription.neptune.aws.upbound.io":                             true,
-	"parametergroup.neptune.aws.upbound.io":                                true,
-	"subnetgroup.neptune.aws.upbound.io":                                   true,
-	"firewallpolicy.networkfirewall.aws.m.upbound.io":                      true,
-	"firewall.networkfirewall.aws.m.upbound.io":                            true,
-	"rulegroup.networkfirewall.aws.m.upbound.io":                           true,
-	"firewallpolicy.networkfirewall.aws.upbound.io":                        true,
-	"firewall.networkfirewall.aws.upbound.io":                              true,
-	"rulegroup.networkfirewall.aws.upbound.io":                             true,
-	"connectattachment.networkmanager.aws.m.upbound.io":                    true,
-	"connection.networkmanager.aws.m.upbound.io":                           true,
-	"corenetwork.networkmanager.aws.m.upbound.io":                          true,
-	"device.networkmanager.aws.m.upbound.io":                               true,
-	"globalnetwork.networkmanager.aws.m.upbound.io":                        true,
-	"link.networkmanager.aws.m.upbound.io":                                 true,
-	"site.networkmanager.aws.m.upbound.io":                                 true,
-	"vpcattachment.networkmanager.aws.m.upbound.io":                        true,
-	"connectattachment.networkmanager.aws.upbound.io":                      true,
-	"connection.networkmanager.aws.upbound.io":                             true,
-	"corenetwork.networkmanager.aws.upbound.io":                            true,
-	"device.networkmanager.aws.upbound.io":                                 true,
-	"globalnetwork.networkmanager.aws.upbound.io":                          true,
-	"link.networkmanager.aws.upbound.io":                                   true,
-	"site.networkmanager.aws.upbound.io":                                   true,
-	"vpcattachment.networkmanager.aws.upbound.io":                          true,
-	"domain.opensearch.aws.m.upbound.io":                                   true,
-	"domain.opensearch.aws.upbound.io":                                     true,
-	"collection.opensearchserverless.aws.m.upbound.io":                     true,
-	"collection.opensearchserverless.aws.upbound.io":                       true,
-	"account.organizations.aws.m.upbound.io":                               true,
-	"organizationalunit.organizations.aws.m.upbound.io":                    true,
-	"policy.organizations.aws.m.upbound.io":                                true,
-	"account.organizations.aws.upbound.io":                                 true,
-	"organizationalunit.organizations.aws.upbound.io":                      true,
-	"policy.organizations.aws.upbound.io":                                  true,
-	"pipeline.osis.aws.m.upbound.io":                                       true,
-	"pipeline.osis.aws.upbound.io":                                         true,
-	"app.pinpoint.aws.m.upbound.io":                                        true,
-	"app.pinpoint.aws.upbound.io":                                          true,
-	"pipe.pipes.aws.m.upbound.io":                                          true,
-	"pipe.pipes.aws.upbound.io":                                            true,
-	"ledger.qldb.aws.m.upbound.io":                                         true,
-	"stream.qldb.aws.m.upbound.io":                                         true,
-	"ledger.qldb.aws.upbound.io":                                           true,
-	"stream.qldb.aws.upbound.io":                                           true,
-	"resourceshare.ram.aws.m.upbound.io":                                   true,
-	"resourceshare.ram.aws.upbound.io":                                     true,
-	"clusterendpoint.rds.aws.m.upbound.io":                                 true,
-	"clusterinstance.rds.aws.m.upbound.io":                                 true,
-	"clusterparametergroup.rds.aws.m.upbound.io":                           true,
-	"cluster.rds.aws.m.upbound.io":                                         true,
-	"clustersnapshot.rds.aws.m.upbound.io":                                 true,
-	"dbsnapshotcopy.rds.aws.m.upbound.io":                                  true,
-	"eventsubscription.rds.aws.m.upbound.io":                               true,
-	"globalcluster.rds.aws.m.upbound.io":                                   true,
-	"instance.rds.aws.m.upbound.io":                                        true,
-	"optiongroup.rds.aws.m.upbound.io":                                     true,
-	"parametergroup.rds.aws.m.upbound.io":                                  true,
-	"proxy.rds.aws.m.upbound.io":                                           true,
-	"proxyendpoint.rds.aws.m.upbound.io":                                   true,
-	"snapshot.rds.aws.m.upbound.io":                                        true,
-	"subnetgroup.rds.aws.m.upbound.io":                                     true,
-	"clusterendpoint.rds.aws.upbound.io":                                   true,
-	"clusterinstance.rds.aws.upbound.io":                                   true,
-	"clusterparametergroup.rds.aws.upbound.io":                             true,
-	"cluster.rds.aws.upbound.io":                                           true,
-	"clustersnapshot.rds.aws.upbound.io":                                   true,
-	"dbsnapshotcopy.rds.aws.upbound.io":                                    true,
-	"eventsubscription.rds.aws.upbound.io":                                 true,
-	"globalcluster.rds.aws.upbound.io":                                     true,
-	"instance.rds.aws.upbound.io":                                          true,
-	"optiongroup.rds.aws.upbound.io":                                       true,
-	"parametergroup.rds.aws.upbound.io":                                    true,
-	"proxy.rds.aws.upbound.io":                                             true,
-	"proxyendpoint.rds.aws.upbound.io":                                     true,
-	"snapshot.rds.aws.upbound.io":                                          true,
-	"subnetgroup.rds.aws.upbound.io":                                       true,
-	"cluster.redshift.aws.m.upbound.io":                                    true,
-	"eventsubscription.redshift.aws.m.upbound.io":                          true,
-	"hsmclientcertificate.redshift.aws.m.upbound.io":                       true,
-	"hsmconfiguration.redshift.aws.m.upbound.io":                           true,
-	"parametergroup.redshift.aws.m.upbound.io":                             true,
-	"snapshotcopygrant.redshift.aws.m.upbound.io":                          true,
-	"snapshotschedule.redshift.aws.m.upbound.io":                           true,
-	"subnetgroup.redshift.aws.m.upbound.io":                                true,
-	"usagelimit.redshift.aws.m.upbound.io":                                 true,
-	"cluster.redshift.aws.upbound.io":                                      true,
-	"eventsubscription.redshift.aws.upbound.io":                            true,
-	"hsmclientcertificate.redshift.aws.upbound.io":                         true,
-	"hsmconfiguration.redshift.aws.upbound.io":                             true,
-	"parametergroup.redshift.aws.upbound.io":                               true,
-	"snapshotcopygrant.redshift.aws.upbound.io":                            true,
-	"snapshotschedule.redshift.aws.upbound.io":                             true,
-	"subnetgroup.redshift.aws.upbound.io":                                  true,
-	"usagelimit.redshift.aws.upbound.io":                                   true,
-	"redshiftserverlessnamespace.redshiftserverless.aws.m.upbound.io":      true,
-	"workgroup.redshiftserverless.aws.m.upbound.io":                        true,
-	"redshiftserverlessnamespace.redshiftserverless.aws.upbound.io":        true,
-	"workgroup.redshiftserverless.aws.upbound.io":                          true,
-	"group.resourcegroups.aws.m.upbound.io":                                true,
-	"group.resourcegroups.aws.upbound.io":                                  true,
-	"profile.rolesanywhere.aws.m.upbound.io":                               true,
-	"profile.rolesanywhere.aws.upbound.io":                                 true,
-	"healthcheck.route53.aws.m.upbound.io":                                 true,
-	"zone.route53.aws.m.upbound.io":                                        true,
-	"healthcheck.route53.aws.upbound.io":                                   true,
-	"zone.route53.aws.upbound.io":                                          true,
-	"cell.route53recoveryreadiness.aws.m.upbound.io":                       true,
-	"readinesscheck.route53recoveryreadiness.aws.m.upbound.io":             true,
-	"recoverygroup.route53recoveryreadiness.aws.m.upbound.io":              true,
-	"resourceset.route53recoveryreadiness.aws.m.upbound.io":                true,
-	"cell.route53recoveryreadiness.aws.upbound.io":                         true,
-	"readinesscheck.route53recoveryreadiness.aws.upbound.io":               true,
-	"recoverygroup.route53recoveryreadiness.aws.upbound.io":                true,
-	"resourceset.route53recoveryreadiness.aws.upbound.io":                  true,
-	"endpoint.route53resolver.aws.m.upbound.io":                            true,
-	"rule.route53resolver.aws.m.upbound.io":                                true,
-	"endpoint.route53resolver.aws.upbound.io":                              true,
-	"rule.route53resolver.aws.upbound.io":                                  true,
-	"appmonitor.rum.aws.m.upbound.io":                                      true,
-	"appmonitor.rum.aws.upbound.io":                                        true,
-	"bucketobject.s3.aws.m.upbound.io":                                     true,
-	"bucket.s3.aws.m.upbound.io":                                           true,
-	"directorybucket.s3.aws.m.upbound.io":                                  true,
-	"objectcopy.s3.aws.m.upbound.io":                                       true,
-	"object.s3.aws.m.upbound.io":                                           true,
-	"bucketobject.s3.aws.upbound.io":                                       true,
-	"bucket.s3.aws.upbound.io":                                             true,
-	"directorybucket.s3.aws.upbound.io":                                    true,
-	"objectcopy.s3.aws.upbound.io":                                         true,
-	"object.s3.aws.upbound.io":                                             true,
-	"accesspoint.s3control.aws.m.upbound.io":                               true,
-	"storagelensconfiguration.s3control.aws.m.upbound.io":                  true,
-	"accesspoint.s3control.aws.upbound.io":                                 true,
-	"storagelensconfiguration.s3control.aws.upbound.io":                    true,
-	"appimageconfig.sagemaker.aws.m.upbound.io":                            true,
-	"app.sagemaker.aws.m.upbound.io":                                       true,
-	"coderepository.sagemaker.aws.m.upbound.io":                            true,
-	"devicefleet.sagemaker.aws.m.upbound.io":                               true,
-	"domain.sagemaker.aws.m.upbound.io":                                    true,
-	"endpointconfiguration.sagemaker.aws.m.upbound.io":                     true,
-	"endpoint.sagemaker.aws.m.upbound.io":                                  true,
-	"featuregroup.sagemaker.aws.m.upbound.io":                              true,
-	"image.sagemaker.aws.m.upbound.io":                                     true,
-	"mlflowtrackingserver.sagemaker.aws.m.upbound.io":                      true,
-	"modelpackagegroup.sagemaker.aws.m.upbound.io":                         true,
-	"model.sagemaker.aws.m.upbound.io":                                     true,
-	"notebookinstancelifecycleconfiguration.sagemaker.aws.m.upbound.io":    true,
-	"notebookinstance.sagemaker.aws.m.upbound.io":                          true,
-	"space.sagemaker.aws.m.upbound.io":                                     true,
-	"studiolifecycleconfig.sagemaker.aws.m.upbound.io":                     true,
-	"userprofile.sagemaker.aws.m.upbound.io":                               true,
-	"workteam.sagemaker.aws.m.upbound.io":                                  true,
-	"appimageconfig.sagemaker.aws.upbound.io":                              true,
-	"app.sagemaker.aws.upbound.io":                                         true,
-	"coderepository.sagemaker.aws.upbound.io":                              true,
-	"devicefleet.sagemaker.aws.upbound.io":                                 true,
-	"domain.sagemaker.aws.upbound.io":                                      true,
-	"endpointconfiguration.sagemaker.aws.upbound.io":                       true,
-	"endpoint.sagemaker.aws.upbound.io":                                    true,
-	"featuregroup.sagemaker.aws.upbound.io":                                true,
-	"image.sagemaker.aws.upbound.io":                                       true,
-	"mlflowtrackingserver.sagemaker.aws.upbound.io":                        true,
-	"modelpackagegroup.sagemaker.aws.upbound.io":                           true,
-	"model.sagemaker.aws.upbound.io":                                       true,
-	"notebookinstancelifecycleconfiguration.sagemaker.aws.upbound.io":      true,
-	"notebookinstance.sagemaker.aws.upbound.io":                            true,
-	"space.sagemaker.aws.upbound.io":                                       true,
-	"studiolifecycleconfig.sagemaker.aws.upbound.io":                       true,
-	"userprofile.sagemaker.aws.upbound.io":                                 true,
-	"workteam.sagemaker.aws.upbound.io":                                    true,
-	"schedulegroup.scheduler.aws.m.upbound.io":                             true,
-	"schedulegroup.scheduler.aws.upbound.io":                               true,
-	"discoverer.schemas.aws.m.upbound.io":                                  true,
-	"registry.schemas.aws.m.upbound.io":                                    true,
-	"schema.schemas.aws.m.upbound.io":                                      true,
-	"discoverer.schemas.aws.upbound.io":                                    true,
-	"registry.schemas.aws.upbound.io":                                      true,
-	"schema.schemas.aws.upbound.io":                                        true,
-	"secret.secretsmanager.aws.m.upbound.io":                               true,
-	"secret.secretsmanager.aws.upbound.io":                                 true,
-	"cloudformationstack.serverlessrepo.aws.m.upbound.io":                  true,
-	"cloudformationstack.serverlessrepo.aws.upbound.io":                    true,
-	"portfolio.servicecatalog.aws.m.upbound.io":                            true,
-	"product.servicecatalog.aws.m.upbound.io":                              true,
-	"portfolio.servicecatalog.aws.upbound.io":                              true,
-	"product.servicecatalog.aws.upbound.io":                                true,
-	"httpnamespace.servicediscovery.aws.m.upbound.io":                      true,
-	"privatednsnamespace.servicediscovery.aws.m.upbound.io":                true,
-	"publicdnsnamespace.servicediscovery.aws.m.upbound.io":                 true,
-	"service.servicediscovery.aws.m.upbound.io":                            true,
-	"httpnamespace.servicediscovery.aws.upbound.io":                        true,
-	"privatednsnamespace.servicediscovery.aws.upbound.io":                  true,
-	"publicdnsnamespace.servicediscovery.aws.upbound.io":                   true,
-	"service.servicediscovery.aws.upbound.io":                              true,
-	"configurationset.sesv2.aws.m.upbound.io":                              true,
-	"dedicatedippool.sesv2.aws.m.upbound.io":                               true,
-	"emailidentity.sesv2.aws.m.upbound.io":                                 true,
-	"configurationset.sesv2.aws.upbound.io":                                true,
-	"dedicatedippool.sesv2.aws.upbound.io":                                 true,
-	"emailidentity.sesv2.aws.upbound.io":                                   true,
-	"activity.sfn.aws.m.upbound.io":                                        true,
-	"statemachine.sfn.aws.m.upbound.io":                                    true,
-	"activity.sfn.aws.upbound.io":                                          true,
-	"statemachine.sfn.aws.upbound.io":                                      true,
-	"signingprofile.signer.aws.m.upbound.io":                               true,
-	"signingprofile.signer.aws.upbound.io":                                 true,
-	"topic.sns.aws.m.upbound.io":                                           true,
-	"topic.sns.aws.upbound.io":                                             true,
-	"queue.sqs.aws.m.upbound.io":                                           true,
-	"queue.sqs.aws.upbound.io":                                             true,
-	"activation.ssm.aws.m.upbound.io":                                      true,
-	"association.ssm.aws.m.upbound.io":                                     true,
-	"document.ssm.aws.m.upbound.io":                                        true,
-	"maintenancewindow.ssm.aws.m.upbound.io":                               true,
-	"parameter.ssm.aws.m.upbound.io":                                       true,
-	"patchbaseline.ssm.aws.m.upbound.io":                                   true,
-	"activation.ssm.aws.upbound.io":                                        true,
-	"association.ssm.aws.upbound.io":                                       true,
-	"document.ssm.aws.upbound.io":                                          true,
-	"maintenancewindow.ssm.aws.upbound.io":                                 true,
-	"parameter.ssm.aws.upbound.io":                                         true,
-	"patchbaseline.ssm.aws.upbound.io":                                     true,
-	"permissionset.ssoadmin.aws.m.upbound.io":                              true,
-	"permissionset.ssoadmin.aws.upbound.io":                                true,
-	"domain.swf.aws.m.upbound.io":                                          true,
-	"domain.swf.aws.upbound.io":                                            true,
-	"database.timestreamwrite.aws.m.upbound.io":                            true,
-	"table.timestreamwrite.aws.m.upbound.io":                               true,
-	"database.timestreamwrite.aws.upbound.io":                              true,
-	"table.timestreamwrite.aws.upbound.io":                                 true,
-	"languagemodel.transcribe.aws.m.upbound.io":                            true,
-	"vocabulary.transcribe.aws.m.upbound.io":                               true,
-	"vocabularyfilter.transcribe.aws.m.upbound.io":                         true,
-	"languagemodel.transcribe.aws.upbound.io":                              true,
-	"vocabulary.transcribe.aws.upbound.io":                                 true,
-	"vocabularyfilter.transcribe.aws.upbound.io":                           true,
-	"connector.transfer.aws.m.upbound.io":                                  true,
-	"server.transfer.aws.m.upbound.io":                                     true,
-	"user.transfer.aws.m.upbound.io":                                       true,
-	"workflow.transfer.aws.m.upbound.io":                                   true,
-	"connector.transfer.aws.upbound.io":                                    true,
-	"server.transfer.aws.upbound.io":                                       true,
-	"user.transfer.aws.upbound.io":                                         true,
-	"workflow.transfer.aws.upbound.io":                                     true,
-	"endpoint.verifiedaccess.aws.m.upbound.io":                             true,
-	"group.verifiedaccess.aws.m.upbound.io":                                true,
-	"instance.verifiedaccess.aws.m.upbound.io":                             true,
-	"trustprovider.verifiedaccess.aws.m.upbound.io":                        true,
-	"endpoint.verifiedaccess.aws.upbound.io":                               true,
-	"group.verifiedaccess.aws.upbound.io":                                  true,
-	"instance.verifiedaccess.aws.upbound.io":                               true,
-	"trustprovider.verifiedaccess.aws.upbound.io":                          true,
-	"accesslogsubscription.vpclattice.aws.m.upbound.io":                    true,
-	"listenerrule.vpclattice.aws.m.upbound.io":                             true,
-	"listener.vpclattice.aws.m.upbound.io":                                 true,
-	"resourceconfiguration.vpclattice.aws.m.upbound.io":                    true,
-	"resourcegateway.vpclattice.aws.m.upbound.io":                          true,
-	"servicenetworkresourceassociation.vpclattice.aws.m.upbound.io":        true,
-	"servicenetwork.vpclattice.aws.m.upbound.io":                           true,
-	"servicenetworkserviceassociation.vpclattice.aws.m.upbound.io":         true,
-	"servicenetworkvpcassociation.vpclattice.aws.m.upbound.io":             true,
-	"service.vpclattice.aws.m.upbound.io":                                  true,
-	"targetgroup.vpclattice.aws.m.upbound.io":                              true,
-	"accesslogsubscription.vpclattice.aws.upbound.io":                      true,
-	"listenerrule.vpclattice.aws.upbound.io":                               true,
-	"listener.vpclattice.aws.upbound.io":                                   true,
-	"resourceconfiguration.vpclattice.aws.upbound.io":                      true,
-	"resourcegateway.vpclattice.aws.upbound.io":                            true,
-	"servicenetworkresourceassociation.vpclattice.aws.upbound.io":          true,
-	"servicenetwork.vpclattice.aws.upbound.io":                             true,
-	"servicenetworkserviceassociation.vpclattice.aws.upbound.io":           true,
-	"servicenetworkvpcassociation.vpclattice.aws.upbound.io":               true,
-	"service.vpclattice.aws.upbound.io":                                    true,
-	"targetgroup.vpclattice.aws.upbound.io":                                true,
-	"ratebasedrule.waf.aws.m.upbound.io":                                   true,
-	"rule.waf.aws.m.upbound.io":                                            true,
-	"webacl.waf.aws.m.upbound.io":                                          true,
-	"ratebasedrule.waf.aws.upbound.io":                                     true,
-	"rule.waf.aws.upbound.io":                                              true,
-	"webacl.waf.aws.upbound.io":                                            true,
-	"ratebasedrule.wafregional.aws.m.upbound.io":                           true,
-	"rule.wafregional.aws.m.upbound.io":                                    true,
-	"webacl.wafregional.aws.m.upbound.io":                                  true,
-	"ratebasedrule.wafregional.aws.upbound.io":                             true,
-	"rule.wafregional.aws.upbound.io":                                      true,
-	"webacl.wafregional.aws.upbound.io":                                    true,
-	"ipset.wafv2.aws.m.upbound.io":                                         true,
-	"regexpatternset.wafv2.aws.m.upbound.io":                               true,
-	"rulegroup.wafv2.aws.m.upbound.io":                                     true,
-	"webacl.wafv2.aws.m.upbound.io":                                        true,
-	"ipset.wafv2.aws.upbound.io":                                           true,
-	"regexpatternset.wafv2.aws.upbound.io":                                 true,
-	"rulegroup.wafv2.aws.upbound.io":                                       true,
-	"webacl.wafv2.aws.upbound.io":                                          true,
-	"directory.workspaces.aws.m.upbound.io":                                true,
-	"ipgroup.workspaces.aws.m.upbound.io":                                  true,
-	"directory.workspaces.aws.upbound.io":                                  true,
-	"ipgroup.workspaces.aws.upbound.io":                                    true,
-	"group.xray.aws.m.upbound.io":                                          true,
-	"samplingrule.xray.aws.m.upbound.io":                                   true,
-	"group.xray.aws.upbound.io":                                            true,
-	"samplingrule.xray.aws.upbound.io":                                     true,
-}
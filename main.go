@@ -3,12 +3,21 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/function-sdk-go"
+
+	"github.com/gympass/function-aws-importer/input/v1beta1"
+	"github.com/gympass/function-aws-importer/internal/inventory"
 )
 
 // CLI of this Function.
@@ -19,6 +28,16 @@ type CLI struct {
 	Address     string `help:"Address at which to listen for gRPC connections." default:":9443"`
 	TLSCertsDir string `help:"Directory containing server certs (tls.key, tls.crt) and the CA used to verify client certificates (ca.crt)" env:"TLS_SERVER_CERTS_DIR"`
 	Insecure    bool   `help:"Run without mTLS credentials. If you supply this flag --tls-server-certs-dir will be ignored."`
+
+	TaggableKinds string `help:"YAML or JSON file listing kinds that extend or override the ones the function knows to support tags." type:"existingfile" env:"TAGGABLE_KINDS_FILE"`
+
+	TaggableKindsDiscoveryTTL time.Duration `help:"How long taggable kinds discovered from CRDs are cached. Zero disables caching." default:"10m" env:"TAGGABLE_KINDS_DISCOVERY_TTL"`
+
+	AWSConfigAggregator string `help:"Name of the AWS Config aggregator the AWSConfig finder queries. The finder is disabled if unset." env:"AWS_CONFIG_AGGREGATOR"`
+	AWSConfigAccountID  string `help:"Account the resources found by the AWSConfig finder must belong to, as aggregators may span several accounts. Resources of any account are found if unset." env:"AWS_CONFIG_ACCOUNT_ID"`
+
+	AWSBackend   string `help:"Where external resources are looked up: \"aws\", or \"file\" to serve Tagging API lookups from --aws-inventory without AWS credentials, disabling all other finders." enum:"aws,file" default:"aws" env:"AWS_BACKEND"`
+	AWSInventory string `help:"YAML or JSON file listing the ARNs and tags of external resources, used with --aws-backend=file." type:"existingfile" env:"AWS_INVENTORY_FILE"`
 }
 
 // Run this Function.
@@ -28,25 +47,49 @@ func (c *CLI) Run() error {
 		return err
 	}
 
-	client, err := c.newResourceGroupsTaggingClient()
-	if err != nil {
-		return errors.Wrap(err, "building Resource Groups Tagging API client")
+	var taggableKinds []v1beta1.TaggableKind
+	if len(c.TaggableKinds) > 0 {
+		taggableKinds, err = loadTaggableKinds(c.TaggableKinds)
+		if err != nil {
+			return errors.Wrap(err, "loading taggable kinds")
+		}
+	}
 
+	fn := &Function{
+		log:           log,
+		taggableKinds: taggableKinds,
+		discovery:     newKindDiscovery(c.TaggableKindsDiscoveryTTL),
 	}
 
-	return function.Serve(&Function{log: log, client: client},
-		function.Listen(c.Network, c.Address),
-		function.MTLSCertificates(c.TLSCertsDir),
-		function.Insecure(c.Insecure))
-}
+	switch c.AWSBackend {
+	case "file":
+		if len(c.AWSInventory) == 0 {
+			return errors.New("--aws-inventory is required when --aws-backend=file")
+		}
+		fn.client, err = inventory.Load(c.AWSInventory)
+		if err != nil {
+			return errors.Wrap(err, "loading AWS inventory")
+		}
+	default:
+		sdkConfig, err := config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return errors.Wrap(err, "loading default AWS SDK configuration")
+		}
 
-func (c *CLI) newResourceGroupsTaggingClient() (*resourcegroupstaggingapi.Client, error) {
-	sdkConfig, err := config.LoadDefaultConfig(context.Background())
-	if err != nil {
-		return nil, errors.Wrap(err, "loading default AWS SDK configuration")
+		fn.client = resourcegroupstaggingapi.NewFromConfig(sdkConfig)
+		fn.securityGroups = ec2.NewFromConfig(sdkConfig)
+		fn.hostedZones = route53.NewFromConfig(sdkConfig)
+		fn.cloudControl = cloudcontrol.NewFromConfig(sdkConfig)
+		fn.iam = iam.NewFromConfig(sdkConfig)
+		fn.awsConfig = configservice.NewFromConfig(sdkConfig)
+		fn.awsConfigAggregator = c.AWSConfigAggregator
+		fn.awsConfigAccountID = c.AWSConfigAccountID
 	}
 
-	return resourcegroupstaggingapi.NewFromConfig(sdkConfig), nil
+	return function.Serve(fn,
+		function.Listen(c.Network, c.Address),
+		function.MTLSCertificates(c.TLSCertsDir),
+		function.Insecure(c.Insecure))
 }
 
 func main() {
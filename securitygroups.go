@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/crossplane/function-sdk-go/logging"
+
+	"github.com/gympass/function-aws-importer/internal"
+)
+
+// securityGroupNaturalKeyFields are the fields holding a security group's name and VPC ID, which uniquely identify it,
+// in each kind of security group
+var securityGroupNaturalKeyFields = map[string]struct{ name, vpcID string }{
+	"securitygroup.ec2.aws.upbound.io":    {name: "spec.forProvider.name", vpcID: "spec.forProvider.vpcId"},
+	"securitygroup.ec2.aws.m.upbound.io":  {name: "spec.forProvider.name", vpcID: "spec.forProvider.vpcId"},
+	"securitygroup.ec2.aws.crossplane.io": {name: "spec.forProvider.groupName", vpcID: "spec.forProvider.vpcId"},
+}
+
+// securityGroupFinder looks EC2 security groups up by their VPC ID and name, which are unique, so security groups created
+// before the function existed or stripped of their tags can still be imported
+type securityGroupFinder struct {
+	log    logging.Logger
+	client ec2.DescribeSecurityGroupsAPIClient
+}
+
+// UsesTags returns false, as security groups are looked up by their natural key
+func (f securityGroupFinder) UsesTags() bool { return false }
+
+// Find returns no candidates if the resource isn't a security group, or if it has no name or VPC ID set
+func (f securityGroupFinder) Find(ctx context.Context, req FindRequest) ([]Candidate, error) {
+	desiredComposed := req.Resource
+	fields, ok := securityGroupNaturalKeyFields[desiredComposed.GroupKind()]
+	if !ok {
+		return nil, nil
+	}
+
+	name, hasName, err := desiredComposed.DesiredString(fields.name)
+	if err != nil {
+		return nil, err
+	}
+	vpcID, hasVPCID, err := desiredComposed.DesiredString(fields.vpcID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasName || !hasVPCID {
+		f.log.Debug("Cannot look security group up by natural key, name or VPC ID is not set",
+			"resource", desiredComposed.CompositionName(),
+		)
+		return nil, nil
+	}
+
+	securityGroups, err := f.describeSecurityGroups(ctx, desiredComposed, name, vpcID)
+	if err != nil {
+		return nil, fmt.Errorf("describing security groups named %q in VPC %q: %w", name, vpcID, err)
+	}
+
+	var candidates []Candidate
+	for _, sg := range securityGroups {
+		if namesOtherResource(securityGroupTags(sg), req) {
+			f.log.Debug("Skipped security group tagged as another resource",
+				"resource", desiredComposed.CompositionName(),
+				"securityGroup", aws.ToString(sg.GroupId),
+			)
+			continue
+		}
+		candidates = append(candidates, Candidate{
+			ARN:          aws.ToString(sg.SecurityGroupArn),
+			Tags:         securityGroupTags(sg),
+			ExternalName: aws.ToString(sg.GroupId),
+		})
+	}
+	return candidates, nil
+}
+
+func (f securityGroupFinder) describeSecurityGroups(ctx context.Context, desiredComposed internal.Resource, name, vpcID string) ([]ec2types.SecurityGroup, error) {
+	region, _, err := desiredComposed.DesiredString("spec.forProvider.region")
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(f.client, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{Name: aws.String("group-name"), Values: []string{name}},
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+		},
+	})
+
+	var securityGroups []ec2types.SecurityGroup
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, func(o *ec2.Options) {
+			// security groups are regional, and the resource's region may not be the function's default one
+			if len(region) > 0 {
+				o.Region = region
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		securityGroups = append(securityGroups, page.SecurityGroups...)
+	}
+	return securityGroups, nil
+}
+
+// securityGroupTags converts sg's tags to Resource Groups Tagging API ones, so tag filters can be applied to them
+func securityGroupTags(sg ec2types.SecurityGroup) []types.Tag {
+	var tags []types.Tag
+	for _, t := range sg.Tags {
+		tags = append(tags, types.Tag{Key: t.Key, Value: t.Value})
+	}
+	return tags
+}
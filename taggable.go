@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/gympass/function-aws-importer/input/v1beta1"
+	"github.com/gympass/function-aws-importer/internal"
+)
+
+// loadTaggableKinds reads a YAML or JSON list of taggable kinds from path, as in the input's additionalTaggableKinds
+func loadTaggableKinds(path string) ([]v1beta1.TaggableKind, error) {
+	b, err := os.ReadFile(path) //nolint:gosec // reading a user-provided file is the point
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %v", path, err)
+	}
+
+	var kinds []v1beta1.TaggableKind
+	if err := yaml.UnmarshalStrict(b, &kinds); err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", path, err)
+	}
+
+	for _, k := range kinds {
+		if err := k.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid taggable kind in %s: %v", path, err)
+		}
+	}
+	return kinds, nil
+}
+
+// tagsOverrides merges taggable kinds into overrides of the built-in kinds registry, on top of base, which may be nil.
+// Later kinds take precedence over earlier ones of the same group-kind.
+func tagsOverrides(base internal.TagsOverrides, kinds ...[]v1beta1.TaggableKind) internal.TagsOverrides {
+	overrides := internal.TagsOverrides{}
+	for gk, o := range base {
+		overrides[gk] = o
+	}
+	for _, ks := range kinds {
+		for _, k := range ks {
+			if k.Disabled {
+				overrides[k.NormalizedGroupKind()] = internal.TagsOverride{}
+				continue
+			}
+			overrides[k.NormalizedGroupKind()] = internal.TagsOverride{
+				Field: k.Field(),
+				List:  k.Shape() == v1beta1.TagsShapeList,
+			}
+		}
+	}
+	return overrides
+}